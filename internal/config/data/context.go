@@ -20,7 +20,9 @@ type Context struct {
 	View         *View        `yaml:"view"`
 	FeatureGates FeatureGates `yaml:"featureGates"`
 	Proxy        *Proxy       `yaml:"proxy"`
-	mx           sync.RWMutex
+	// ShellCheck overrides the shell pod shell detection command for this context.
+	ShellCheck string `yaml:"shellCheck,omitempty"`
+	mx         sync.RWMutex
 }
 
 // NewContext creates a new cluster configuration.
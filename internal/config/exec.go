@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import "time"
+
+// DefaultMaxOutputBytes tracks the default size cap of captured command output.
+const DefaultMaxOutputBytes int64 = 16 << 20
+
+// DefaultExecBackoff tracks the default initial delay between background command retries.
+const DefaultExecBackoff = time.Second
+
+// Exec tracks exec and shell commands options.
+type Exec struct {
+	// Env tracks environment variables injected into exec commands.
+	// These take precedence over the current environment.
+	Env map[string]string `json:"env,omitempty" yaml:"env,omitempty"`
+
+	// MaxOutputBytes caps the captured output of one-shot commands. Defaults to 16MB.
+	MaxOutputBytes int64 `json:"maxOutputBytes,omitempty" yaml:"maxOutputBytes,omitempty"`
+
+	// Retries tracks how many times background commands are retried on transient failures.
+	Retries int `json:"retries,omitempty" yaml:"retries,omitempty"`
+
+	// Backoff tracks the initial delay between retries ie 2s. The delay doubles on each attempt.
+	Backoff string `json:"backoff,omitempty" yaml:"backoff,omitempty"`
+
+	// PluginGlobalFlags tracks the kubectl plugins accepting the kubectl global flags
+	// ie --context, --kubeconfig or impersonation flags.
+	PluginGlobalFlags []string `json:"pluginGlobalFlags,omitempty" yaml:"pluginGlobalFlags,omitempty"`
+
+	// NoBanner disables the banner printed when shelling into a container.
+	NoBanner bool `json:"noBanner,omitempty" yaml:"noBanner,omitempty"`
+
+	// BannerFormat tracks the shell banner template ie {{.Pod}} on {{.Container}}.
+	BannerFormat string `json:"bannerFormat,omitempty" yaml:"bannerFormat,omitempty"`
+}
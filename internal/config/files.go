@@ -23,6 +23,9 @@ const (
 	// K9sEnvLogsDir represents k9s logs dir env var.
 	K9sEnvLogsDir = "K9S_LOGS_DIR"
 
+	// K9sEnvTmpDir represents k9s exec scratch files dir env var.
+	K9sEnvTmpDir = "K9S_TMP_DIR"
+
 	// AppName tracks k9s app name.
 	AppName = "k9s"
 
@@ -72,6 +75,9 @@ var (
 	// AppViewsFile tracks custom views config file.
 	AppViewsFile string
 
+	// AppFiltersFile tracks named filters config file.
+	AppFiltersFile string
+
 	// AppAliasesFile tracks aliases config file.
 	AppAliasesFile string
 
@@ -155,6 +161,7 @@ func initK9sEnvLocs() error {
 	AppAliasesFile = filepath.Join(AppConfigDir, "aliases.yaml")
 	AppPluginsFile = filepath.Join(AppConfigDir, "plugins.yaml")
 	AppViewsFile = filepath.Join(AppConfigDir, "views.yaml")
+	AppFiltersFile = filepath.Join(AppConfigDir, "filters.yaml")
 
 	return nil
 }
@@ -176,6 +183,7 @@ func initXDGLocs() error {
 	AppAliasesFile = filepath.Join(AppConfigDir, "aliases.yaml")
 	AppPluginsFile = filepath.Join(AppConfigDir, "plugins.yaml")
 	AppViewsFile = filepath.Join(AppConfigDir, "views.yaml")
+	AppFiltersFile = filepath.Join(AppConfigDir, "filters.yaml")
 
 	AppSkinsDir = filepath.Join(AppConfigDir, "skins")
 	if e := data.EnsureFullPath(AppSkinsDir, data.DefaultDirMod); e != nil {
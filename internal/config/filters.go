@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"io/fs"
+	"log/slog"
+	"maps"
+	"os"
+	"slices"
+	"sync"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/config/json"
+	"github.com/derailed/k9s/internal/slogs"
+	"gopkg.in/yaml.v3"
+)
+
+// FilterSetting represents a saved table filter.
+type FilterSetting struct {
+	Filter        string `yaml:"filter"`
+	Invert        bool   `yaml:"invert,omitempty"`
+	Toast         bool   `yaml:"toast,omitempty"`
+	ChangedOnly   bool   `yaml:"changedOnly,omitempty"`
+	Limit         int    `yaml:"limit,omitempty"`
+	FuzzyOnFields bool   `yaml:"fuzzyOnFields,omitempty"`
+	ClientLabels  bool   `yaml:"clientLabels,omitempty"`
+}
+
+// FilterSettings tracks named filters.
+type FilterSettings map[string]FilterSetting
+
+// CustomFilters represents a collection of named filters keyed by resource.
+type CustomFilters struct {
+	Filters map[string]FilterSettings `yaml:"filters"`
+	mx      sync.RWMutex
+}
+
+// NewCustomFilters returns a new instance.
+func NewCustomFilters() *CustomFilters {
+	return &CustomFilters{
+		Filters: make(map[string]FilterSettings),
+	}
+}
+
+// Load loads named filters from disk.
+func (c *CustomFilters) Load(path string) error {
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := data.JSONValidator.Validate(json.FiltersSchema, bb); err != nil {
+		slog.Warn("Validation failed. Please update your config and restart!",
+			slogs.Path, path,
+			slogs.Error, err,
+		)
+	}
+	var in CustomFilters
+	if err := yaml.Unmarshal(bb, &in); err != nil {
+		return err
+	}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.Filters = in.Filters
+	if c.Filters == nil {
+		c.Filters = make(map[string]FilterSettings)
+	}
+
+	return nil
+}
+
+// Save saves named filters to disk.
+func (c *CustomFilters) Save(path string) error {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	if err := data.EnsureDirPath(path, data.DefaultDirMod); err != nil {
+		return err
+	}
+
+	return data.SaveYAML(path, c)
+}
+
+// Set adds or updates a named filter for a given resource.
+func (c *CustomFilters) Set(gvr, name string, f FilterSetting) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if _, ok := c.Filters[gvr]; !ok {
+		c.Filters[gvr] = make(FilterSettings)
+	}
+	c.Filters[gvr][name] = f
+}
+
+// Get returns a named filter for a given resource.
+func (c *CustomFilters) Get(gvr, name string) (FilterSetting, bool) {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	f, ok := c.Filters[gvr][name]
+
+	return f, ok
+}
+
+// Delete removes a named filter for a given resource.
+func (c *CustomFilters) Delete(gvr, name string) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	delete(c.Filters[gvr], name)
+	if len(c.Filters[gvr]) == 0 {
+		delete(c.Filters, gvr)
+	}
+}
+
+// Names returns the sorted filter names for a given resource.
+func (c *CustomFilters) Names(gvr string) []string {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	return slices.Sorted(maps.Keys(c.Filters[gvr]))
+}
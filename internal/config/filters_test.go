@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomFiltersSaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "filters.yaml")
+
+	cf := config.NewCustomFilters()
+	cf.Set("v1/pods", "busted", config.FilterSetting{Filter: "Running", Invert: true, Toast: true})
+	cf.Set("v1/pods", "app", config.FilterSetting{Filter: "app=fred"})
+	cf.Set("apps/v1/deployments", "fred", config.FilterSetting{Filter: "fred", Limit: 10})
+	require.NoError(t, cf.Save(path))
+
+	cf1 := config.NewCustomFilters()
+	require.NoError(t, cf1.Load(path))
+	assert.Equal(t, []string{"app", "busted"}, cf1.Names("v1/pods"))
+	f, ok := cf1.Get("v1/pods", "busted")
+	assert.True(t, ok)
+	assert.Equal(t, config.FilterSetting{Filter: "Running", Invert: true, Toast: true}, f)
+	f, ok = cf1.Get("apps/v1/deployments", "fred")
+	assert.True(t, ok)
+	assert.Equal(t, 10, f.Limit)
+
+	cf1.Delete("apps/v1/deployments", "fred")
+	_, ok = cf1.Get("apps/v1/deployments", "fred")
+	assert.False(t, ok)
+	assert.Empty(t, cf1.Names("apps/v1/deployments"))
+}
+
+func TestCustomFiltersLoadMissing(t *testing.T) {
+	cf := config.NewCustomFilters()
+
+	require.NoError(t, cf.Load("testdata/filters/blee.yaml"))
+	assert.Empty(t, cf.Names("v1/pods"))
+}
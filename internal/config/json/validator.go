@@ -32,6 +32,9 @@ const (
 	// ViewsSchema describes views schema.
 	ViewsSchema = "views.json"
 
+	// FiltersSchema describes named filters schema.
+	FiltersSchema = "filters.json"
+
 	// HotkeysSchema describes hotkeys schema.
 	HotkeysSchema = "hotkeys.json"
 
@@ -61,6 +64,9 @@ var (
 	//go:embed schemas/views.json
 	viewsSchema string
 
+	//go:embed schemas/filters.json
+	filtersSchema string
+
 	//go:embed schemas/k9s.json
 	k9sSchema string
 
@@ -88,6 +94,7 @@ func NewValidator() *Validator {
 			ContextSchema:     gojsonschema.NewStringLoader(contextSchema),
 			AliasesSchema:     gojsonschema.NewStringLoader(aliasSchema),
 			ViewsSchema:       gojsonschema.NewStringLoader(viewsSchema),
+			FiltersSchema:     gojsonschema.NewStringLoader(filtersSchema),
 			PluginsSchema:     gojsonschema.NewStringLoader(pluginsSchema),
 			PluginSchema:      gojsonschema.NewStringLoader(pluginSchema),
 			PluginMultiSchema: gojsonschema.NewStringLoader(pluginMultiSchema),
@@ -138,6 +138,10 @@ func TestValidateK9s(t *testing.T) {
 			f:   "testdata/k9s/toast.yaml",
 			err: `Additional property shellPods is not allowed`,
 		},
+		"grace-key": {
+			f:   "testdata/k9s/grace.yaml",
+			err: `Additional property gracePeriodSeconds is not allowed`,
+		},
 	}
 
 	v := json.NewValidator()
@@ -11,8 +11,11 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config/data"
@@ -37,6 +40,10 @@ type K9s struct {
 	Logger              Logger     `json:"logger" yaml:"logger"`
 	Thresholds          Threshold  `json:"thresholds" yaml:"thresholds"`
 	DefaultView         string     `json:"defaultView" yaml:"defaultView"`
+	KubectlBinary       string     `json:"kubectlBinary,omitempty" yaml:"kubectlBinary,omitempty"`
+	Exec                *Exec      `json:"exec,omitempty" yaml:"exec,omitempty"`
+	TempDir             string     `json:"tempDir,omitempty" yaml:"tempDir,omitempty"`
+	DeleteRetention     string     `json:"deleteRetention,omitempty" yaml:"deleteRetention,omitempty"`
 	manualRefreshRate   int
 	manualReadOnly      *bool
 	manualCommand       *string
@@ -127,6 +134,10 @@ func (k *K9s) Merge(k1 *K9s) {
 	k.UI = k1.UI
 	k.SkipLatestRevCheck = k1.SkipLatestRevCheck
 	k.DisablePodCounting = k1.DisablePodCounting
+	k.KubectlBinary = k1.KubectlBinary
+	k.Exec = k1.Exec
+	k.TempDir = k1.TempDir
+	k.DeleteRetention = k1.DeleteRetention
 	k.ShellPod = k1.ShellPod
 	k.Logger = k1.Logger
 	k.ImageScans = k1.ImageScans
@@ -135,6 +146,70 @@ func (k *K9s) Merge(k1 *K9s) {
 	}
 }
 
+// ExecEnv returns the environment variables to inject into exec commands.
+func (k *K9s) ExecEnv() map[string]string {
+	if k.Exec == nil {
+		return nil
+	}
+
+	return k.Exec.Env
+}
+
+// ExecMaxOutputBytes returns the captured command output size cap.
+func (k *K9s) ExecMaxOutputBytes() int64 {
+	if k.Exec == nil || k.Exec.MaxOutputBytes <= 0 {
+		return DefaultMaxOutputBytes
+	}
+
+	return k.Exec.MaxOutputBytes
+}
+
+// ExecRetry returns the background commands retries count and initial backoff.
+func (k *K9s) ExecRetry() (int, time.Duration) {
+	if k.Exec == nil || k.Exec.Retries <= 0 {
+		return 0, 0
+	}
+	d, err := time.ParseDuration(k.Exec.Backoff)
+	if err != nil || d <= 0 {
+		d = DefaultExecBackoff
+	}
+
+	return k.Exec.Retries, d
+}
+
+// ExecBanner returns the shell banner template and whether the banner is enabled.
+// An empty template means the default banner.
+func (k *K9s) ExecBanner() (string, bool) {
+	if k.Exec == nil {
+		return "", true
+	}
+
+	return k.Exec.BannerFormat, !k.Exec.NoBanner
+}
+
+// tmpDirMod tracks the exec scratch files dir perms.
+const tmpDirMod os.FileMode = 0o700
+
+// ExecTempDir returns the dir exec commands scratch files land in, creating it if need be.
+// The K9S_TMP_DIR env var takes precedence over the configured dir.
+// Defaults to the system temp dir ie $TMPDIR.
+func (k *K9s) ExecTempDir() (string, error) {
+	dir := os.Getenv(K9sEnvTmpDir)
+	if dir == "" {
+		dir = k.TempDir
+	}
+	if dir == "" {
+		return os.TempDir(), nil
+	}
+
+	return dir, data.EnsureFullPath(dir, tmpDirMod)
+}
+
+// PluginAcceptsGlobalFlags checks if the given kubectl plugin supports the kubectl global flags.
+func (k *K9s) PluginAcceptsGlobalFlags(plugin string) bool {
+	return k.Exec != nil && slices.Contains(k.Exec.PluginGlobalFlags, plugin)
+}
+
 // AppScreenDumpDir fetch screen dumps dir.
 func (k *K9s) AppScreenDumpDir() string {
 	d := k.ScreenDumpDir
@@ -360,6 +435,23 @@ func (k *K9s) GetRefreshRate() int {
 	return k.RefreshRate
 }
 
+// GetDeleteRetention returns how long deleted resources linger in views. Defaults to 0.
+func (k *K9s) GetDeleteRetention() time.Duration {
+	if k.DeleteRetention == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(k.DeleteRetention)
+	if err != nil || d < 0 {
+		slog.Warn("Invalid delete retention. Disabling",
+			slogs.Duration, k.DeleteRetention,
+			slogs.Error, err,
+		)
+		return 0
+	}
+
+	return d
+}
+
 // IsReadOnly returns the readonly setting.
 func (k *K9s) IsReadOnly() bool {
 	ro := k.ReadOnly
@@ -389,6 +481,16 @@ func (k *K9s) Validate(c client.Connection, contextName, clusterName string) {
 		k.PortForwardAddress = defaultPFAddress()
 	}
 
+	if k.KubectlBinary != "" {
+		if _, err := exec.LookPath(k.KubectlBinary); err != nil {
+			slog.Warn("Invalid kubectl binary. Falling back to kubectl in path",
+				slogs.Bin, k.KubectlBinary,
+				slogs.Error, err,
+			)
+			k.KubectlBinary = ""
+		}
+	}
+
 	if k.getActiveConfig() == nil {
 		_, _ = k.ActivateContext(contextName)
 	}
@@ -5,7 +5,10 @@ package config_test
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/config/mock"
@@ -147,3 +150,213 @@ func TestAppScreenDumpDir(t *testing.T) {
 	require.NoError(t, cfg.Load("testdata/configs/k9s.yaml", true))
 	assert.Equal(t, "/tmp/k9s-test/screen-dumps", cfg.K9s.AppScreenDumpDir())
 }
+
+func TestK9sValidateKubectlBinary(t *testing.T) {
+	cl, ct := "cl-1", "ct-1-1"
+
+	uu := map[string]struct {
+		bin, e string
+	}{
+		"blank": {},
+		"in-path": {
+			bin: "sh",
+			e:   "sh",
+		},
+		"missing": {
+			bin: "/blee/duh/kubectl",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			k := config.NewK9s(
+				mock.NewMockConnection(),
+				mock.NewMockKubeSettings(&genericclioptions.ConfigFlags{
+					ClusterName: &cl,
+					Context:     &ct,
+				}),
+			)
+			k.KubectlBinary = u.bin
+			k.Validate(mock.NewMockConnection(), ct, cl)
+			assert.Equal(t, u.e, k.KubectlBinary)
+		})
+	}
+}
+
+func TestK9sExecEnv(t *testing.T) {
+	k := config.NewK9s(nil, nil)
+	assert.Nil(t, k.ExecEnv())
+
+	k.Exec = &config.Exec{Env: map[string]string{"HTTPS_PROXY": "http://fred"}}
+	assert.Equal(t, map[string]string{"HTTPS_PROXY": "http://fred"}, k.ExecEnv())
+}
+
+func TestK9sExecMaxOutputBytes(t *testing.T) {
+	k := config.NewK9s(nil, nil)
+	assert.Equal(t, config.DefaultMaxOutputBytes, k.ExecMaxOutputBytes())
+
+	k.Exec = &config.Exec{MaxOutputBytes: -1}
+	assert.Equal(t, config.DefaultMaxOutputBytes, k.ExecMaxOutputBytes())
+
+	k.Exec = &config.Exec{MaxOutputBytes: 1024}
+	assert.Equal(t, int64(1024), k.ExecMaxOutputBytes())
+}
+
+func TestK9sExecRetry(t *testing.T) {
+	uu := map[string]struct {
+		exec    *config.Exec
+		retries int
+		backoff time.Duration
+	}{
+		"none": {},
+		"no-retries": {
+			exec: &config.Exec{Backoff: "2s"},
+		},
+		"default-backoff": {
+			exec:    &config.Exec{Retries: 3},
+			retries: 3,
+			backoff: config.DefaultExecBackoff,
+		},
+		"bad-backoff": {
+			exec:    &config.Exec{Retries: 2, Backoff: "zorg"},
+			retries: 2,
+			backoff: config.DefaultExecBackoff,
+		},
+		"custom": {
+			exec:    &config.Exec{Retries: 2, Backoff: "500ms"},
+			retries: 2,
+			backoff: 500 * time.Millisecond,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			k := config.NewK9s(nil, nil)
+			k.Exec = u.exec
+			r, b := k.ExecRetry()
+			assert.Equal(t, u.retries, r)
+			assert.Equal(t, u.backoff, b)
+		})
+	}
+}
+
+func TestK9sExecTempDir(t *testing.T) {
+	root := t.TempDir()
+	uu := map[string]struct {
+		env, cfg, e string
+	}{
+		"default": {
+			e: os.TempDir(),
+		},
+		"config": {
+			cfg: filepath.Join(root, "cfg", "tmp"),
+			e:   filepath.Join(root, "cfg", "tmp"),
+		},
+		"env": {
+			env: filepath.Join(root, "env", "tmp"),
+			cfg: filepath.Join(root, "cfg", "tmp"),
+			e:   filepath.Join(root, "env", "tmp"),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			t.Setenv(config.K9sEnvTmpDir, u.env)
+			k := config.NewK9s(nil, nil)
+			k.TempDir = u.cfg
+
+			dir, err := k.ExecTempDir()
+			require.NoError(t, err)
+			assert.Equal(t, u.e, dir)
+			fi, err := os.Stat(dir)
+			require.NoError(t, err)
+			assert.True(t, fi.IsDir())
+			if u.cfg != "" {
+				assert.Equal(t, os.FileMode(0o700), fi.Mode().Perm())
+			}
+		})
+	}
+}
+
+func TestK9sGetDeleteRetention(t *testing.T) {
+	uu := map[string]struct {
+		r string
+		e time.Duration
+	}{
+		"none":     {},
+		"valid":    {r: "10s", e: 10 * time.Second},
+		"invalid":  {r: "zorg"},
+		"negative": {r: "-1s"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			k := config.NewK9s(nil, nil)
+			k.DeleteRetention = u.r
+			assert.Equal(t, u.e, k.GetDeleteRetention())
+		})
+	}
+}
+
+func TestK9sPluginAcceptsGlobalFlags(t *testing.T) {
+	uu := map[string]struct {
+		exec   *config.Exec
+		plugin string
+		e      bool
+	}{
+		"no-exec": {
+			plugin: "neat",
+		},
+		"undeclared": {
+			exec:   &config.Exec{PluginGlobalFlags: []string{"krew"}},
+			plugin: "neat",
+		},
+		"declared": {
+			exec:   &config.Exec{PluginGlobalFlags: []string{"krew", "neat"}},
+			plugin: "neat",
+			e:      true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			k := config.K9s{Exec: u.exec}
+			assert.Equal(t, u.e, k.PluginAcceptsGlobalFlags(u.plugin))
+		})
+	}
+}
+
+func TestK9sExecBanner(t *testing.T) {
+	uu := map[string]struct {
+		exec *config.Exec
+		f    string
+		ok   bool
+	}{
+		"none": {
+			ok: true,
+		},
+		"custom": {
+			exec: &config.Exec{BannerFormat: "{{.Pod}}"},
+			f:    "{{.Pod}}",
+			ok:   true,
+		},
+		"disabled": {
+			exec: &config.Exec{NoBanner: true},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			k := config.K9s{Exec: u.exec}
+			f, ok := k.ExecBanner()
+			assert.Equal(t, u.f, f)
+			assert.Equal(t, u.ok, ok)
+		})
+	}
+}
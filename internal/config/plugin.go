@@ -40,6 +40,7 @@ type Plugin struct {
 	Background      bool     `yaml:"background"`
 	Dangerous       bool     `yaml:"dangerous"`
 	OverwriteOutput bool     `yaml:"overwriteOutput"`
+	Pager           bool     `yaml:"pager"`
 }
 
 func (p Plugin) String() string {
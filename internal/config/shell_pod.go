@@ -4,10 +4,29 @@
 package config
 
 import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"maps"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/slogs"
+	"gopkg.in/yaml.v3"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-const defaultDockerShellImage = "busybox:1.35.0"
+// istioInjectAnnotation toggles istio sidecars injection.
+const istioInjectAnnotation = "sidecar.istio.io/inject"
+
+const (
+	defaultDockerShellImage = "busybox:1.35.0"
+	defaultRootMountPath    = "/"
+	defaultRootMountTarget  = "/host"
+)
 
 // Limits represents resource limits.
 type Limits map[v1.ResourceName]string
@@ -19,11 +38,112 @@ type ShellPod struct {
 	Args             []string                  `json:"args,omitempty" yaml:"args,omitempty"`
 	Namespace        string                    `json:"namespace" yaml:"namespace"`
 	Limits           Limits                    `json:"limits,omitempty" yaml:"limits,omitempty"`
+	Requests         Limits                    `json:"requests,omitempty" yaml:"requests,omitempty"`
 	Labels           map[string]string         `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Annotations      map[string]string         `json:"annotations,omitempty" yaml:"annotations,omitempty"`
 	ImagePullSecrets []v1.LocalObjectReference `json:"imagePullSecrets,omitempty" yaml:"imagePullSecrets,omitempty"`
 	ImagePullPolicy  v1.PullPolicy             `json:"imagePullPolicy,omitempty" yaml:"imagePullPolicy,omitempty"`
 	TTY              bool                      `json:"tty,omitempty" yaml:"tty,omitempty"`
 	HostPathVolume   []hostPathVolume          `json:"hostPathVolume,omitempty" yaml:"hostPathVolume,omitempty"`
+	ImageAllowlist   []string                  `json:"imageAllowlist,omitempty" yaml:"imageAllowlist,omitempty"`
+	// RootMountPath tracks the host path mounted read-only in the shell pod. Defaults to /.
+	RootMountPath string `json:"rootMountPath,omitempty" yaml:"rootMountPath,omitempty"`
+	// RootMountTarget tracks where the root mount lands in the shell pod. Defaults to /host.
+	RootMountTarget string `json:"rootMountTarget,omitempty" yaml:"rootMountTarget,omitempty"`
+	// ReadOnly forces node shells to run unprivileged with a read-only filesystem and a restricted shell.
+	ReadOnly bool `json:"readOnly,omitempty" yaml:"readOnly,omitempty"`
+	// TerminationGracePeriodSeconds tracks the shell pod termination and deletion grace period. Defaults to 0.
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty" yaml:"terminationGracePeriodSeconds,omitempty"`
+	// Tolerations tracks the shell pod tolerations. Defaults to tolerating all taints.
+	Tolerations []v1.Toleration `json:"tolerations,omitempty" yaml:"-"`
+	// NodeSelector tracks the labels of nodes eligible to run a shell pod not pinned to a node.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty" yaml:"nodeSelector,omitempty"`
+	// Affinity tracks the scheduling constraints of a shell pod not pinned to a node.
+	Affinity *v1.Affinity `json:"affinity,omitempty" yaml:"-"`
+	// MaxLifetime tracks how long a node shell may live before its pod is deleted. Zero means no limit.
+	MaxLifetime metav1.Duration `json:"maxLifetime,omitempty" yaml:"-"`
+	// PostExecCommand tracks a command run in the shell pod right before it gets deleted.
+	PostExecCommand []string `json:"postExecCommand,omitempty" yaml:"postExecCommand,omitempty"`
+	// MountCRISocket mounts the node container runtime socket in the shell pod ie for crictl.
+	MountCRISocket bool `json:"mountCRISocket,omitempty" yaml:"mountCRISocket,omitempty"`
+	// CRISocketPath overrides the container runtime socket path detected from the node runtime.
+	CRISocketPath string `json:"criSocketPath,omitempty" yaml:"criSocketPath,omitempty"`
+	// AllowConcurrentShells keeps prior node shells alive when launching a new one. Defaults to false.
+	AllowConcurrentShells bool `json:"allowConcurrentShells,omitempty" yaml:"allowConcurrentShells,omitempty"`
+	// ShellCheck tracks the command detecting the shell to run when no command is set.
+	// Defaults to running bash when available or sh otherwise.
+	ShellCheck string `json:"shellCheck,omitempty" yaml:"shellCheck,omitempty"`
+	// LoginShell runs the detected shell as a login shell so profile scripts are sourced.
+	LoginShell bool `json:"loginShell,omitempty" yaml:"loginShell,omitempty"`
+}
+
+// shellPodYAML serializes MaxLifetime as a duration string and the Kubernetes
+// scheduling types using their json field names since yaml honors neither.
+type shellPodYAML struct {
+	*shellPodAlias `yaml:",inline"`
+	MaxLifetime    string           `yaml:"maxLifetime,omitempty"`
+	Affinity       map[string]any   `yaml:"affinity,omitempty"`
+	Tolerations    []map[string]any `yaml:"tolerations,omitempty"`
+}
+
+type shellPodAlias ShellPod
+
+// UnmarshalYAML decodes a shell pod configuration.
+func (s *ShellPod) UnmarshalYAML(n *yaml.Node) error {
+	raw := shellPodYAML{shellPodAlias: (*shellPodAlias)(s)}
+	if err := n.Decode(&raw); err != nil {
+		return err
+	}
+	if raw.Tolerations != nil {
+		if err := convertJSON(raw.Tolerations, &s.Tolerations); err != nil {
+			return fmt.Errorf("invalid shell pod tolerations: %w", err)
+		}
+	}
+	if raw.Affinity != nil {
+		if err := convertJSON(raw.Affinity, &s.Affinity); err != nil {
+			return fmt.Errorf("invalid shell pod affinity: %w", err)
+		}
+	}
+	if raw.MaxLifetime == "" {
+		return nil
+	}
+	d, err := time.ParseDuration(raw.MaxLifetime)
+	if err != nil {
+		return fmt.Errorf("invalid shell pod maxLifetime %q: %w", raw.MaxLifetime, err)
+	}
+	s.MaxLifetime = metav1.Duration{Duration: d}
+
+	return nil
+}
+
+// MarshalYAML encodes a shell pod configuration.
+func (s ShellPod) MarshalYAML() (any, error) {
+	raw := shellPodYAML{shellPodAlias: (*shellPodAlias)(&s)}
+	if s.MaxLifetime.Duration > 0 {
+		raw.MaxLifetime = s.MaxLifetime.Duration.String()
+	}
+	if len(s.Tolerations) > 0 {
+		if err := convertJSON(s.Tolerations, &raw.Tolerations); err != nil {
+			return nil, err
+		}
+	}
+	if s.Affinity != nil {
+		if err := convertJSON(s.Affinity, &raw.Affinity); err != nil {
+			return nil, err
+		}
+	}
+
+	return raw, nil
+}
+
+// convertJSON converts in to out via their json representations.
+func convertJSON(in, out any) error {
+	bb, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(bb, out)
 }
 
 type hostPathVolume struct {
@@ -36,9 +156,10 @@ type hostPathVolume struct {
 // NewShellPod returns a new instance.
 func NewShellPod() *ShellPod {
 	return &ShellPod{
-		Image:     defaultDockerShellImage,
-		Namespace: "default",
-		Limits:    defaultLimits(),
+		Image:           defaultDockerShellImage,
+		Namespace:       "default",
+		Limits:          defaultLimits(),
+		ImagePullPolicy: v1.PullIfNotPresent,
 	}
 }
 
@@ -47,9 +168,202 @@ func (s *ShellPod) Validate() {
 	if s.Image == "" {
 		s.Image = defaultDockerShellImage
 	}
+	s.Limits, s.Requests = validQuantities("limit", s.Limits), validQuantities("request", s.Requests)
 	if len(s.Limits) == 0 {
 		s.Limits = defaultLimits()
 	}
+	if s.RootMountPath != "" && !filepath.IsAbs(s.RootMountPath) {
+		slog.Warn("Shell pod root mount path must be absolute. Using default",
+			slogs.Path, s.RootMountPath,
+		)
+		s.RootMountPath = ""
+	}
+	if s.RootMountTarget != "" && !filepath.IsAbs(s.RootMountTarget) {
+		slog.Warn("Shell pod root mount target must be absolute. Using default",
+			slogs.Path, s.RootMountTarget,
+		)
+		s.RootMountTarget = ""
+	}
+	if s.ImagePullPolicy == "" {
+		s.ImagePullPolicy = v1.PullIfNotPresent
+	}
+	if err := s.ValidatePullPolicy(); err != nil {
+		slog.Warn("Invalid shell pod config", slogs.Error, err)
+	}
+	if s.TerminationGracePeriodSeconds != nil && *s.TerminationGracePeriodSeconds < 0 {
+		slog.Warn("Invalid shell pod grace period. Using default",
+			slogs.GracePeriod, *s.TerminationGracePeriodSeconds,
+		)
+		s.TerminationGracePeriodSeconds = nil
+	}
+	s.Tolerations = validTolerations(s.Tolerations)
+	if s.MaxLifetime.Duration < 0 {
+		slog.Warn("Invalid shell pod max lifetime. Disabling",
+			slogs.Duration, s.MaxLifetime.Duration,
+		)
+		s.MaxLifetime = metav1.Duration{}
+	}
+}
+
+// PodTolerations returns the shell pod tolerations or a toleration matching all taints if none are set.
+func (s *ShellPod) PodTolerations() []v1.Toleration {
+	if len(s.Tolerations) == 0 {
+		return []v1.Toleration{{Operator: v1.TolerationOpExists}}
+	}
+
+	return s.Tolerations
+}
+
+// PodAnnotations returns the shell pod annotations. Mesh sidecars injection is
+// disabled unless overridden.
+func (s *ShellPod) PodAnnotations() map[string]string {
+	aa := make(map[string]string, len(s.Annotations)+1)
+	aa[istioInjectAnnotation] = "false"
+	maps.Copy(aa, s.Annotations)
+
+	return aa
+}
+
+// HasScheduling checks if node selectors or affinities are set.
+func (s *ShellPod) HasScheduling() bool {
+	return len(s.NodeSelector) > 0 || s.Affinity != nil
+}
+
+// RootMount returns the host path and container path of the shell pod root mount.
+func (s *ShellPod) RootMount() (path, target string) {
+	path, target = s.RootMountPath, s.RootMountTarget
+	if path == "" {
+		path = defaultRootMountPath
+	}
+	if target == "" {
+		target = defaultRootMountTarget
+	}
+
+	return path, target
+}
+
+// criSockets tracks the default socket paths of known container runtimes.
+var criSockets = []struct{ runtime, path string }{
+	{runtime: "containerd", path: "/run/containerd/containerd.sock"},
+	{runtime: "cri-o", path: "/var/run/crio/crio.sock"},
+	{runtime: "docker", path: "/var/run/cri-dockerd.sock"},
+}
+
+// CRISocket returns the container runtime socket path for the given node runtime
+// version ie containerd://1.7.2. The configured path wins if set. Returns an empty
+// string when the runtime is unknown.
+func (s *ShellPod) CRISocket(runtime string) string {
+	if s.CRISocketPath != "" {
+		return s.CRISocketPath
+	}
+	rt, _, _ := strings.Cut(runtime, "://")
+	for _, c := range criSockets {
+		if c.runtime == rt {
+			return c.path
+		}
+	}
+
+	return ""
+}
+
+// GracePeriod returns the shell pod termination grace period in seconds.
+func (s *ShellPod) GracePeriod() int64 {
+	if s.TerminationGracePeriodSeconds == nil {
+		return 0
+	}
+
+	return *s.TerminationGracePeriodSeconds
+}
+
+// IsImageAllowed checks if the given image is allowed for the shell pod.
+// An empty allowlist allows all images. Entries ending with * match by prefix.
+func (s *ShellPod) IsImageAllowed(img string) bool {
+	if len(s.ImageAllowlist) == 0 {
+		return true
+	}
+	for _, a := range s.ImageAllowlist {
+		if prefix, ok := strings.CutSuffix(a, "*"); ok {
+			if strings.HasPrefix(img, prefix) {
+				return true
+			}
+			continue
+		}
+		if a == img {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidatePullPolicy checks the image pull policy is one of Always, IfNotPresent or Never.
+func (s *ShellPod) ValidatePullPolicy() error {
+	switch s.ImagePullPolicy {
+	case v1.PullAlways, v1.PullIfNotPresent, v1.PullNever:
+		return nil
+	default:
+		return fmt.Errorf("invalid shell pod imagePullPolicy %q. Must be one of %s, %s or %s",
+			s.ImagePullPolicy, v1.PullAlways, v1.PullIfNotPresent, v1.PullNever)
+	}
+}
+
+func validQuantities(kind string, l Limits) Limits {
+	if len(l) == 0 {
+		return l
+	}
+	vv := make(Limits, len(l))
+	for k, q := range l {
+		if _, err := resource.ParseQuantity(q); err != nil {
+			slog.Warn("Invalid shell pod resource quantity. Skipping",
+				slogs.Type, kind,
+				slogs.Name, k,
+				slogs.Error, err,
+			)
+			continue
+		}
+		vv[k] = q
+	}
+
+	return vv
+}
+
+func validTolerations(tt []v1.Toleration) []v1.Toleration {
+	if len(tt) == 0 {
+		return tt
+	}
+	vv := make([]v1.Toleration, 0, len(tt))
+	for _, t := range tt {
+		if err := validateToleration(t); err != nil {
+			slog.Warn("Invalid shell pod toleration. Skipping", slogs.Error, err)
+			continue
+		}
+		vv = append(vv, t)
+	}
+
+	return vv
+}
+
+func validateToleration(t v1.Toleration) error {
+	switch t.Operator {
+	case "", v1.TolerationOpEqual:
+	case v1.TolerationOpExists:
+		if t.Value != "" {
+			return fmt.Errorf("toleration %q value must be empty when operator is %s", t.Key, t.Operator)
+		}
+	default:
+		return fmt.Errorf("invalid toleration %q operator %q. Must be one of %s or %s",
+			t.Key, t.Operator, v1.TolerationOpEqual, v1.TolerationOpExists)
+	}
+	switch t.Effect {
+	case "", v1.TaintEffectNoSchedule, v1.TaintEffectPreferNoSchedule, v1.TaintEffectNoExecute:
+	default:
+		return fmt.Errorf("invalid toleration %q effect %q", t.Key, t.Effect)
+	}
+	if t.Key == "" && t.Operator != v1.TolerationOpExists {
+		return fmt.Errorf("toleration with an empty key must use the %s operator", v1.TolerationOpExists)
+	}
+
+	return nil
 }
 
 func defaultLimits() Limits {
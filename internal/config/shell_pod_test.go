@@ -0,0 +1,438 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestShellPodIsImageAllowed(t *testing.T) {
+	uu := map[string]struct {
+		allow []string
+		img   string
+		e     bool
+	}{
+		"empty": {
+			img: "busybox:1.35.0",
+			e:   true,
+		},
+		"exact": {
+			allow: []string{"busybox:1.35.0"},
+			img:   "busybox:1.35.0",
+			e:     true,
+		},
+		"exact-miss": {
+			allow: []string{"busybox:1.35.0"},
+			img:   "busybox:latest",
+		},
+		"glob": {
+			allow: []string{"fred", "registry.io/blee/*"},
+			img:   "registry.io/blee/shell:1.0",
+			e:     true,
+		},
+		"glob-miss": {
+			allow: []string{"registry.io/blee/*"},
+			img:   "registry.io/duh/shell:1.0",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := config.NewShellPod()
+			s.ImageAllowlist = u.allow
+			assert.Equal(t, u.e, s.IsImageAllowed(u.img))
+		})
+	}
+}
+
+func TestShellPodValidateGracePeriod(t *testing.T) {
+	uu := map[string]struct {
+		grace *int64
+		e     int64
+	}{
+		"default": {},
+		"custom": {
+			grace: int64Ptr(10),
+			e:     10,
+		},
+		"negative": {
+			grace: int64Ptr(-1),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := config.NewShellPod()
+			s.TerminationGracePeriodSeconds = u.grace
+			s.Validate()
+			assert.Equal(t, u.e, s.GracePeriod())
+		})
+	}
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
+func TestShellPodValidatePullPolicy(t *testing.T) {
+	uu := map[string]struct {
+		policy v1.PullPolicy
+		e      v1.PullPolicy
+		err    string
+	}{
+		"empty": {
+			e: v1.PullIfNotPresent,
+		},
+		"always": {
+			policy: v1.PullAlways,
+			e:      v1.PullAlways,
+		},
+		"never": {
+			policy: v1.PullNever,
+			e:      v1.PullNever,
+		},
+		"invalid": {
+			policy: "always",
+			e:      "always",
+			err:    `invalid shell pod imagePullPolicy "always". Must be one of Always, IfNotPresent or Never`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := config.NewShellPod()
+			s.ImagePullPolicy = u.policy
+			s.Validate()
+			assert.Equal(t, u.e, s.ImagePullPolicy)
+			if u.err != "" {
+				assert.EqualError(t, s.ValidatePullPolicy(), u.err)
+			} else {
+				assert.NoError(t, s.ValidatePullPolicy())
+			}
+		})
+	}
+}
+
+func TestShellPodRootMount(t *testing.T) {
+	uu := map[string]struct {
+		path, target   string
+		ePath, eTarget string
+	}{
+		"default": {
+			ePath:   "/",
+			eTarget: "/host",
+		},
+		"custom": {
+			path:    "/var/log",
+			target:  "/logs",
+			ePath:   "/var/log",
+			eTarget: "/logs",
+		},
+		"relative": {
+			path:    "var/log",
+			target:  "logs",
+			ePath:   "/",
+			eTarget: "/host",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := config.NewShellPod()
+			s.RootMountPath, s.RootMountTarget = u.path, u.target
+			s.Validate()
+			path, target := s.RootMount()
+			assert.Equal(t, u.ePath, path)
+			assert.Equal(t, u.eTarget, target)
+		})
+	}
+}
+
+func TestShellPodRootMountYAML(t *testing.T) {
+	uu := map[string]struct {
+		raw            string
+		ePath, eTarget string
+	}{
+		"default": {
+			raw:     "image: fred\n",
+			ePath:   "/",
+			eTarget: "/host",
+		},
+		"custom": {
+			raw:     "image: fred\nrootMountPath: /var/log\nrootMountTarget: /logs\n",
+			ePath:   "/var/log",
+			eTarget: "/logs",
+		},
+		"relative": {
+			raw:     "image: fred\nrootMountPath: var/log\nrootMountTarget: logs\n",
+			ePath:   "/",
+			eTarget: "/host",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := config.NewShellPod()
+			require.NoError(t, yaml.Unmarshal([]byte(u.raw), s))
+			s.Validate()
+			path, target := s.RootMount()
+			assert.Equal(t, u.ePath, path)
+			assert.Equal(t, u.eTarget, target)
+		})
+	}
+}
+
+func TestShellPodMaxLifetime(t *testing.T) {
+	uu := map[string]struct {
+		raw string
+		e   time.Duration
+		err bool
+	}{
+		"none": {
+			raw: "image: fred",
+		},
+		"set": {
+			raw: "image: fred\nmaxLifetime: 30m",
+			e:   30 * time.Minute,
+		},
+		"negative": {
+			raw: "image: fred\nmaxLifetime: -5m",
+		},
+		"toast": {
+			raw: "image: fred\nmaxLifetime: blee",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := config.NewShellPod()
+			err := yaml.Unmarshal([]byte(u.raw), s)
+			if u.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			s.Validate()
+			assert.Equal(t, "fred", s.Image)
+			assert.Equal(t, u.e, s.MaxLifetime.Duration)
+		})
+	}
+}
+
+func TestShellPodMaxLifetimeMarshal(t *testing.T) {
+	s := config.NewShellPod()
+	s.MaxLifetime = metav1.Duration{Duration: 90 * time.Second}
+
+	bb, err := yaml.Marshal(s)
+	require.NoError(t, err)
+	assert.Contains(t, string(bb), "maxLifetime: 1m30s")
+
+	var s1 config.ShellPod
+	require.NoError(t, yaml.Unmarshal(bb, &s1))
+	assert.Equal(t, s.MaxLifetime, s1.MaxLifetime)
+	assert.Equal(t, s.Image, s1.Image)
+}
+
+func TestShellPodValidateTolerations(t *testing.T) {
+	uu := map[string]struct {
+		tt []v1.Toleration
+		e  []v1.Toleration
+	}{
+		"none": {},
+		"valid": {
+			tt: []v1.Toleration{
+				{Key: "gpu", Operator: v1.TolerationOpEqual, Value: "true", Effect: v1.TaintEffectNoSchedule},
+				{Key: "debug", Operator: v1.TolerationOpExists},
+				{Key: "blee", Value: "duh"},
+			},
+			e: []v1.Toleration{
+				{Key: "gpu", Operator: v1.TolerationOpEqual, Value: "true", Effect: v1.TaintEffectNoSchedule},
+				{Key: "debug", Operator: v1.TolerationOpExists},
+				{Key: "blee", Value: "duh"},
+			},
+		},
+		"bad-operator": {
+			tt: []v1.Toleration{
+				{Key: "gpu", Operator: "Matches"},
+				{Key: "debug", Operator: v1.TolerationOpExists},
+			},
+			e: []v1.Toleration{
+				{Key: "debug", Operator: v1.TolerationOpExists},
+			},
+		},
+		"exists-with-value": {
+			tt: []v1.Toleration{{Key: "gpu", Operator: v1.TolerationOpExists, Value: "true"}},
+			e:  []v1.Toleration{},
+		},
+		"bad-effect": {
+			tt: []v1.Toleration{{Key: "gpu", Operator: v1.TolerationOpExists, Effect: "Never"}},
+			e:  []v1.Toleration{},
+		},
+		"empty-key-equal": {
+			tt: []v1.Toleration{{Operator: v1.TolerationOpEqual, Value: "fred"}},
+			e:  []v1.Toleration{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := config.NewShellPod()
+			s.Tolerations = u.tt
+			s.Validate()
+			assert.Equal(t, u.e, s.Tolerations)
+		})
+	}
+}
+
+func TestShellPodTolerationsYAML(t *testing.T) {
+	raw := `image: fred
+tolerations:
+- key: gpu
+  operator: Exists
+  effect: NoSchedule
+`
+	s := config.NewShellPod()
+	require.NoError(t, yaml.Unmarshal([]byte(raw), s))
+	s.Validate()
+	assert.Equal(t, []v1.Toleration{{Key: "gpu", Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule}}, s.PodTolerations())
+
+	assert.Equal(t, []v1.Toleration{{Operator: v1.TolerationOpExists}}, config.NewShellPod().PodTolerations())
+}
+
+func TestShellPodSchedulingYAML(t *testing.T) {
+	raw := `image: fred
+nodeSelector:
+  pool: debug
+affinity:
+  nodeAffinity:
+    requiredDuringSchedulingIgnoredDuringExecution:
+      nodeSelectorTerms:
+      - matchExpressions:
+        - key: kubernetes.io/os
+          operator: In
+          values:
+          - linux
+tolerations:
+- key: gpu
+  operator: Exists
+  effect: NoExecute
+  tolerationSeconds: 30
+`
+	s := config.NewShellPod()
+	require.NoError(t, yaml.Unmarshal([]byte(raw), s))
+	assert.True(t, s.HasScheduling())
+	assert.Equal(t, map[string]string{"pool": "debug"}, s.NodeSelector)
+	require.NotNil(t, s.Affinity)
+	tt := s.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	require.Len(t, tt, 1)
+	assert.Equal(t, []v1.NodeSelectorRequirement{
+		{Key: "kubernetes.io/os", Operator: v1.NodeSelectorOpIn, Values: []string{"linux"}},
+	}, tt[0].MatchExpressions)
+	require.Len(t, s.Tolerations, 1)
+	require.NotNil(t, s.Tolerations[0].TolerationSeconds)
+	assert.Equal(t, int64(30), *s.Tolerations[0].TolerationSeconds)
+
+	bb, err := yaml.Marshal(s)
+	require.NoError(t, err)
+	var s1 config.ShellPod
+	require.NoError(t, yaml.Unmarshal(bb, &s1))
+	assert.Equal(t, s.Affinity, s1.Affinity)
+	assert.Equal(t, s.Tolerations, s1.Tolerations)
+	assert.Equal(t, s.NodeSelector, s1.NodeSelector)
+
+	assert.False(t, config.NewShellPod().HasScheduling())
+}
+
+func TestShellPodValidateResources(t *testing.T) {
+	uu := map[string]struct {
+		limits, requests   config.Limits
+		eLimits, eRequests config.Limits
+	}{
+		"limits-only": {
+			limits:  config.Limits{v1.ResourceCPU: "200m", v1.ResourceMemory: "1Gi"},
+			eLimits: config.Limits{v1.ResourceCPU: "200m", v1.ResourceMemory: "1Gi"},
+		},
+		"requests-only": {
+			requests:  config.Limits{v1.ResourceCPU: "50m"},
+			eLimits:   config.Limits{v1.ResourceCPU: "100m", v1.ResourceMemory: "100Mi"},
+			eRequests: config.Limits{v1.ResourceCPU: "50m"},
+		},
+		"both": {
+			limits:    config.Limits{v1.ResourceCPU: "200m"},
+			requests:  config.Limits{v1.ResourceCPU: "50m"},
+			eLimits:   config.Limits{v1.ResourceCPU: "200m"},
+			eRequests: config.Limits{v1.ResourceCPU: "50m"},
+		},
+		"invalid": {
+			limits:    config.Limits{v1.ResourceCPU: "fred"},
+			requests:  config.Limits{v1.ResourceCPU: "50m", v1.ResourceMemory: "blee"},
+			eLimits:   config.Limits{v1.ResourceCPU: "100m", v1.ResourceMemory: "100Mi"},
+			eRequests: config.Limits{v1.ResourceCPU: "50m"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := config.NewShellPod()
+			s.Limits, s.Requests = u.limits, u.requests
+			s.Validate()
+			assert.Equal(t, u.eLimits, s.Limits)
+			assert.Equal(t, u.eRequests, s.Requests)
+		})
+	}
+}
+
+func TestShellPodCRISocket(t *testing.T) {
+	uu := map[string]struct {
+		path, runtime, e string
+	}{
+		"containerd": {
+			runtime: "containerd://1.7.2",
+			e:       "/run/containerd/containerd.sock",
+		},
+		"crio": {
+			runtime: "cri-o://1.28.1",
+			e:       "/var/run/crio/crio.sock",
+		},
+		"docker": {
+			runtime: "docker://24.0.7",
+			e:       "/var/run/cri-dockerd.sock",
+		},
+		"unknown": {
+			runtime: "fred://1.0",
+		},
+		"none": {},
+		"custom": {
+			path:    "/run/k3s/containerd/containerd.sock",
+			runtime: "containerd://1.7.2",
+			e:       "/run/k3s/containerd/containerd.sock",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := config.NewShellPod()
+			s.CRISocketPath = u.path
+			assert.Equal(t, u.e, s.CRISocket(u.runtime))
+		})
+	}
+}
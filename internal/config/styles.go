@@ -129,6 +129,13 @@ type (
 		Yaml   Yaml   `json:"yaml" yaml:"yaml"`
 		Picker Picker `json:"picker" yaml:"picker"`
 		Log    Log    `json:"logs" yaml:"logs"`
+		Shell  Shell  `json:"shell" yaml:"shell"`
+	}
+
+	// Shell tracks shell banner styles.
+	Shell struct {
+		FgColor Color `json:"fgColor" yaml:"fgColor"`
+		BgColor Color `json:"bgColor" yaml:"bgColor"`
 	}
 
 	// Status tracks resource status styles.
@@ -311,6 +318,7 @@ func newViews() Views {
 		Yaml:   newYaml(),
 		Picker: newPicker(),
 		Log:    newLog(),
+		Shell:  newShell(),
 	}
 }
 
@@ -375,6 +383,13 @@ func newLog() Log {
 	}
 }
 
+func newShell() Shell {
+	return Shell{
+		FgColor: "black",
+		BgColor: "green",
+	}
+}
+
 func newLogIndicator() LogIndicator {
 	return LogIndicator{
 		FgColor:        "dodgerblue",
@@ -564,6 +579,11 @@ func (s *Styles) Xray() Xray {
 	return s.K9s.Views.Xray
 }
 
+// Shell returns shell banner styles.
+func (s *Styles) Shell() Shell {
+	return s.K9s.Views.Shell
+}
+
 // Views returns views styles.
 func (s *Styles) Views() Views {
 	return s.K9s.Views
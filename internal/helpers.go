@@ -13,6 +13,8 @@ import (
 var (
 	fuzzyRx = regexp.MustCompile(`\A-f\s?([\w-]+)\b`)
 	labelRx = regexp.MustCompile(`\A\-l`)
+	exactRx = regexp.MustCompile(`\A-x\s?(\S+)`)
+	ageRx   = regexp.MustCompile(`\A(?i:age)\s*([<>]=?)\s*(\S+)\z`)
 )
 
 // Helpers...
@@ -43,3 +45,24 @@ func IsFuzzySelector(s string) (string, bool) {
 
 	return mm[1], true
 }
+
+// IsExactSelector checks if query must match an entire field.
+func IsExactSelector(s string) (string, bool) {
+	mm := exactRx.FindStringSubmatch(s)
+	if len(mm) != 2 {
+		return "", false
+	}
+
+	return mm[1], true
+}
+
+// IsAgeSelector checks if query filters on resource age ie age>1h or age<=2d.
+// It returns the comparison operator and the duration spec.
+func IsAgeSelector(s string) (op, age string, ok bool) {
+	mm := ageRx.FindStringSubmatch(s)
+	if len(mm) != 3 {
+		return "", "", false
+	}
+
+	return mm[1], mm[2], true
+}
@@ -31,3 +31,54 @@ func TestIsLabelSelector(t *testing.T) {
 		})
 	}
 }
+
+func TestIsExactSelector(t *testing.T) {
+	uu := map[string]struct {
+		s, e string
+		ok   bool
+	}{
+		"empty":    {s: ""},
+		"cool":     {s: "-x web", e: "web", ok: true},
+		"no-space": {s: "-xweb", e: "web", ok: true},
+		"rx":       {s: "-x web-\\d+", e: "web-\\d+", ok: true},
+		"no-flag":  {s: "web"},
+		"fuzzy":    {s: "-f web"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			q, ok := internal.IsExactSelector(u.s)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, q)
+		})
+	}
+}
+
+func TestIsAgeSelector(t *testing.T) {
+	uu := map[string]struct {
+		s, op, age string
+		ok         bool
+	}{
+		"empty":   {s: ""},
+		"older":   {s: "age>1h", op: ">", age: "1h", ok: true},
+		"younger": {s: "age<5m", op: "<", age: "5m", ok: true},
+		"ge":      {s: "age>=2d", op: ">=", age: "2d", ok: true},
+		"le":      {s: "age<=2d3h", op: "<=", age: "2d3h", ok: true},
+		"spaces":  {s: "AGE > 1h", op: ">", age: "1h", ok: true},
+		"no-op":   {s: "age=1h"},
+		"no-age":  {s: "age>"},
+		"trailer": {s: "age>1h fred"},
+		"other":   {s: "page>1h"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			op, age, ok := internal.IsAgeSelector(u.s)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.op, op)
+			assert.Equal(t, u.age, age)
+		})
+	}
+}
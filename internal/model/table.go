@@ -177,6 +177,11 @@ func (t *Table) SetRefreshRate(d time.Duration) {
 	t.refreshRate = d
 }
 
+// SetDeleteRetention sets how long deleted rows linger before being removed.
+func (t *Table) SetDeleteRetention(d time.Duration) {
+	t.data.SetDeleteRetention(d)
+}
+
 // ClusterWide checks if resource is scope for all namespaces.
 func (t *Table) ClusterWide() bool {
 	return client.IsClusterWide(t.data.GetNamespace())
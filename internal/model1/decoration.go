@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import "github.com/derailed/tcell/v2"
+
+// Decoration represents a semantic cue for a table cell.
+type Decoration int
+
+const (
+	// DecorationNone leaves the cell as is.
+	DecorationNone Decoration = iota
+
+	// DecorationOK flags a healthy cell.
+	DecorationOK
+
+	// DecorationPending flags a cell in transition.
+	DecorationPending
+
+	// DecorationError flags a cell in error.
+	DecorationError
+
+	// DecorationHighlight flags a cell of interest.
+	DecorationHighlight
+)
+
+// DecorateFn computes per cell decorations keyed by column index for a given row.
+type DecorateFn func(row Row) map[int]Decoration
+
+// Color returns the cell color for the decoration.
+func (d Decoration) Color() tcell.Color {
+	//nolint:exhaustive
+	switch d {
+	case DecorationOK:
+		return CompletedColor
+	case DecorationPending:
+		return PendingColor
+	case DecorationError:
+		return ErrColor
+	case DecorationHighlight:
+		return HighlightColor
+	default:
+		return StdColor
+	}
+}
+
+func customizeDecorations(dd map[int]Decoration, cols []int) map[int]Decoration {
+	if len(dd) == 0 {
+		return nil
+	}
+	out := make(map[int]Decoration, len(dd))
+	for i, c := range cols {
+		if d, ok := dd[c]; ok {
+			out[i] = d
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ExportOpts represents table export options.
+type ExportOpts struct {
+	// Columns lists the column names to export in order. Defaults to all columns.
+	Columns []string
+
+	// Wide includes wide columns.
+	Wide bool
+
+	// DisplayNames uses column aliases rather than canonical names in the header.
+	DisplayNames bool
+}
+
+// ExportCSV writes the table as CSV.
+func (t *TableData) ExportCSV(w io.Writer, opts ExportOpts) error {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	cols, err := t.exportColumns(opts)
+	if err != nil {
+		return err
+	}
+	cw := csv.NewWriter(w)
+	if err := cw.Write(t.exportHeader(cols, opts.DisplayNames)); err != nil {
+		return err
+	}
+	t.rowEvents.Range(func(_ int, re RowEvent) bool {
+		err = cw.Write(exportFields(re.Row, cols))
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// ExportJSON writes the table as a JSON list of column name to value objects.
+func (t *TableData) ExportJSON(w io.Writer, opts ExportOpts) error {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	cols, err := t.exportColumns(opts)
+	if err != nil {
+		return err
+	}
+	hh := t.exportHeader(cols, opts.DisplayNames)
+	rr := make([]map[string]string, 0, t.rowEvents.Len())
+	t.rowEvents.Range(func(_ int, re RowEvent) bool {
+		ff := exportFields(re.Row, cols)
+		m := make(map[string]string, len(hh))
+		for i, h := range hh {
+			m[h] = ff[i]
+		}
+		rr = append(rr, m)
+		return true
+	})
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(rr)
+}
+
+// ToMarkdown writes the table as a GitHub flavored Markdown table.
+func (t *TableData) ToMarkdown(w io.Writer, opts ExportOpts) error {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	cols, err := t.exportColumns(opts)
+	if err != nil {
+		return err
+	}
+	seps := make([]string, 0, len(cols))
+	for _, c := range cols {
+		if columnAlignment(t.header[c]) == AlignRight {
+			seps = append(seps, "---:")
+		} else {
+			seps = append(seps, ":---")
+		}
+	}
+	if err := writeMarkdownRow(w, t.exportHeader(cols, opts.DisplayNames)); err != nil {
+		return err
+	}
+	if err := writeMarkdownRow(w, seps); err != nil {
+		return err
+	}
+	t.rowEvents.Range(func(_ int, re RowEvent) bool {
+		err = writeMarkdownRow(w, exportFields(re.Row, cols))
+		return err == nil
+	})
+
+	return err
+}
+
+var markdownEscaper = strings.NewReplacer("|", `\|`, "\r\n", " ", "\n", " ")
+
+func writeMarkdownRow(w io.Writer, cells []string) error {
+	var b strings.Builder
+	b.WriteString("|")
+	for _, c := range cells {
+		b.WriteString(" " + markdownEscaper.Replace(c) + " |")
+	}
+	b.WriteString("\n")
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+// exportColumns resolves the exported column indices.
+func (t *TableData) exportColumns(opts ExportOpts) ([]int, error) {
+	if len(opts.Columns) == 0 {
+		cols := make([]int, 0, len(t.header))
+		for i, c := range t.header {
+			if opts.Wide || !c.Wide {
+				cols = append(cols, i)
+			}
+		}
+		return cols, nil
+	}
+
+	cols := make([]int, 0, len(opts.Columns))
+	for _, n := range opts.Columns {
+		idx, ok := t.header.IndexOf(n, opts.Wide)
+		if !ok {
+			return nil, fmt.Errorf("unknown column %q. Valid columns: %s", n, strings.Join(t.header.ColumnNames(opts.Wide), ","))
+		}
+		cols = append(cols, idx)
+	}
+
+	return cols, nil
+}
+
+func (t *TableData) exportHeader(cols []int, display bool) []string {
+	hh := make([]string, 0, len(cols))
+	for _, c := range cols {
+		n := t.header[c].Name
+		if display {
+			n = t.displayName(n)
+		}
+		hh = append(hh, n)
+	}
+
+	return hh
+}
+
+func exportFields(r Row, cols []int) []string {
+	ff := make([]string, 0, len(cols))
+	for _, c := range cols {
+		if c < len(r.Fields) {
+			ff = append(ff, r.Fields[c])
+		} else {
+			ff = append(ff, "")
+		}
+	}
+
+	return ff
+}
@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableDataExportCSV(t *testing.T) {
+	uu := map[string]struct {
+		opts ExportOpts
+		e    string
+		err  string
+	}{
+		"default": {
+			e: "NAME,STATUS,RESTARTS\nfred,Running,0\nblee,Error,3\n",
+		},
+		"wide": {
+			opts: ExportOpts{Wide: true},
+			e:    "NAME,STATUS,RESTARTS,IP\nfred,Running,0,10.0.0.1\nblee,Error,3,10.0.0.2\n",
+		},
+		"columns": {
+			opts: ExportOpts{Columns: []string{"RESTARTS", "NAME"}},
+			e:    "RESTARTS,NAME\n0,fred\n3,blee\n",
+		},
+		"wide-columns": {
+			opts: ExportOpts{Columns: []string{"NAME", "IP"}, Wide: true},
+			e:    "NAME,IP\nfred,10.0.0.1\nblee,10.0.0.2\n",
+		},
+		"wide-column-hidden": {
+			opts: ExportOpts{Columns: []string{"NAME", "IP"}},
+			err:  `unknown column "IP". Valid columns: NAME,STATUS,RESTARTS`,
+		},
+		"unknown": {
+			opts: ExportOpts{Columns: []string{"BLEE"}, Wide: true},
+			err:  `unknown column "BLEE". Valid columns: NAME,STATUS,RESTARTS,IP`,
+		},
+		"canonical-names": {
+			opts: ExportOpts{Columns: []string{"NAME", "RESTARTS"}},
+			e:    "NAME,RESTARTS\nfred,0\nblee,3\n",
+		},
+		"display-names": {
+			opts: ExportOpts{Columns: []string{"NAME", "RESTARTS"}, DisplayNames: true},
+			e:    "NAME,#R\nfred,0\nblee,3\n",
+		},
+	}
+
+	td := exportTable()
+	td.RenameColumn("RESTARTS", "#R")
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var out bytes.Buffer
+			err := td.ExportCSV(&out, u.opts)
+			if u.err != "" {
+				require.EqualError(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.e, out.String())
+		})
+	}
+}
+
+func TestTableDataExportJSON(t *testing.T) {
+	td := exportTable()
+
+	var out bytes.Buffer
+	require.NoError(t, td.ExportJSON(&out, ExportOpts{Columns: []string{"NAME", "RESTARTS"}}))
+	assert.JSONEq(t, `[{"NAME":"fred","RESTARTS":"0"},{"NAME":"blee","RESTARTS":"3"}]`, out.String())
+
+	require.Error(t, td.ExportJSON(&out, ExportOpts{Columns: []string{"BLEE"}}))
+}
+
+func TestTableDataToMarkdown(t *testing.T) {
+	uu := map[string]struct {
+		opts ExportOpts
+		e    string
+		err  string
+	}{
+		"default": {
+			e: "| NAME | STATUS | RESTARTS |\n| :--- | :--- | ---: |\n| fred | Running | 0 |\n| blee | Error | 3 |\n",
+		},
+		"columns": {
+			opts: ExportOpts{Columns: []string{"RESTARTS", "NAME"}},
+			e:    "| RESTARTS | NAME |\n| ---: | :--- |\n| 0 | fred |\n| 3 | blee |\n",
+		},
+		"unknown": {
+			opts: ExportOpts{Columns: []string{"BLEE"}},
+			err:  `unknown column "BLEE". Valid columns: NAME,STATUS,RESTARTS`,
+		},
+	}
+
+	td := exportTable()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var out bytes.Buffer
+			err := td.ToMarkdown(&out, u.opts)
+			if u.err != "" {
+				require.EqualError(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.e, out.String())
+		})
+	}
+}
+
+func TestTableDataToMarkdownEscape(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{HeaderColumn{Name: "NAME"}, HeaderColumn{Name: "MESSAGE"}},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred", "a|b\nc"}}},
+		),
+	)
+
+	var out bytes.Buffer
+	require.NoError(t, td.ToMarkdown(&out, ExportOpts{}))
+	assert.Equal(t, "| NAME | MESSAGE |\n| :--- | :--- |\n| fred | a\\|b c |\n", out.String())
+}
+
+func exportTable() *TableData {
+	return NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "STATUS"},
+			HeaderColumn{Name: "RESTARTS", Attrs: Attrs{Align: tview.AlignRight}},
+			HeaderColumn{Name: "IP", Attrs: Attrs{Wide: true}},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred", "Running", "0", "10.0.0.1"}}},
+			RowEvent{Row: Row{ID: "blee", Fields: Fields{"blee", "Error", "3", "10.0.0.2"}}},
+		),
+	)
+}
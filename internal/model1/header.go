@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log/slog"
 	"reflect"
+	"sync"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/slogs"
@@ -191,6 +192,33 @@ func (h Header) FilterColIndices(ns string, wide bool) sets.Set[int] {
 	return cc
 }
 
+// colIndexCache memoizes a header filter column indices. It is shared across a
+// table copies and replaced whenever the table header changes.
+type colIndexCache struct {
+	ns   string
+	wide bool
+	idx  sets.Set[int]
+	ok   bool
+	mx   sync.Mutex
+}
+
+// filterColIndices returns the cached indices for the given namespace and wide
+// mode or computes them from the header otherwise.
+func (c *colIndexCache) filterColIndices(h Header, ns string, wide bool) sets.Set[int] {
+	if c == nil {
+		return h.FilterColIndices(ns, wide)
+	}
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if c.ok && c.ns == ns && c.wide == wide {
+		return c.idx
+	}
+	c.ns, c.wide, c.idx, c.ok = ns, wide, h.FilterColIndices(ns, wide), true
+
+	return c.idx
+}
+
 // ColumnNames return header col names
 func (h Header) ColumnNames(wide bool) []string {
 	if len(h) == 0 {
@@ -241,6 +269,19 @@ func (h Header) IsCapacityCol(col int) bool {
 	return h[col].Capacity
 }
 
+// volatileIndices returns the indices of columns that may change without a
+// resource update, i.e. metrics and time columns.
+func (h Header) volatileIndices() []int {
+	ii := make([]int, 0, len(h))
+	for i, c := range h {
+		if c.MX || c.Time {
+			ii = append(ii, i)
+		}
+	}
+
+	return ii
+}
+
 // IndexOf returns the col index or -1 if none.
 func (h Header) IndexOf(colName string, includeWide bool) (int, bool) {
 	for i, c := range h {
@@ -4,12 +4,16 @@
 package model1
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"log/slog"
 	"math"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/fvbommel/sortorder"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -19,9 +23,17 @@ import (
 
 const poolSize = 10
 
-func Hydrate(ns string, oo []runtime.Object, rr Rows, re Renderer) error {
-	pool := NewWorkerPool(context.Background(), poolSize)
+// humanAgeRx matches rendered ages ie 3y45d or 2m10s.
+var humanAgeRx = regexp.MustCompile(`\A(\d+[ydhms])+\z`)
+
+// Hydrate renders the given resources into rows.
+// It bails out with the context error when the context is canceled.
+func Hydrate(ctx context.Context, ns string, oo []runtime.Object, rr Rows, re Renderer) error {
+	pool := NewWorkerPool(ctx, poolSize)
 	for i, o := range oo {
+		if i%poolSize == 0 && ctx.Err() != nil {
+			break
+		}
 		pool.Add(func(ctx context.Context) error {
 			select {
 			case <-ctx.Done():
@@ -37,17 +49,22 @@ func Hydrate(ns string, oo []runtime.Object, rr Rows, re Renderer) error {
 		return errs[0]
 	}
 
-	return nil
+	return ctx.Err()
 }
 
-func GenericHydrate(ns string, table *metav1.Table, rr Rows, re Renderer) error {
+// GenericHydrate renders the given table rows.
+// It bails out with the context error when the context is canceled.
+func GenericHydrate(ctx context.Context, ns string, table *metav1.Table, rr Rows, re Renderer) error {
 	gr, ok := re.(Generic)
 	if !ok {
 		return fmt.Errorf("expecting generic renderer but got %T", re)
 	}
 	gr.SetTable(ns, table)
-	pool := NewWorkerPool(context.Background(), poolSize)
+	pool := NewWorkerPool(ctx, poolSize)
 	for i, row := range table.Rows {
+		if i%poolSize == 0 && ctx.Err() != nil {
+			break
+		}
 		pool.Add(func(ctx context.Context) error {
 			select {
 			case <-ctx.Done():
@@ -63,7 +80,7 @@ func GenericHydrate(ns string, table *metav1.Table, rr Rows, re Renderer) error
 		return errs[0]
 	}
 
-	return nil
+	return ctx.Err()
 }
 
 // IsValid returns true if resource is valid, false otherwise.
@@ -105,6 +122,150 @@ func labelize(labels string) map[string]string {
 	return data
 }
 
+// parseKVQuery parses a key=value[,key=value] query. Values may use * as a wildcard.
+func parseKVQuery(q string) (map[string]string, bool) {
+	q = strings.TrimSpace(strings.TrimPrefix(q, "-l"))
+	if q == "" || strings.ContainsAny(q, " !()") {
+		return nil, false
+	}
+	ll := strings.Split(q, ",")
+	kvs := make(map[string]string, len(ll))
+	for _, l := range ll {
+		k, v, ok := strings.Cut(l, "=")
+		if !ok || k == "" || v == "" || strings.Contains(v, "=") {
+			return nil, false
+		}
+		kvs[k] = v
+	}
+
+	return kvs, true
+}
+
+// parseHasQuery parses a has:key query checking for a label or annotation key.
+func parseHasQuery(q string) (string, bool) {
+	k, ok := strings.CutPrefix(strings.TrimSpace(q), "has:")
+	if !ok || k == "" || strings.ContainsAny(k, " ,=") {
+		return "", false
+	}
+
+	return k, true
+}
+
+// hasKey checks if the given key=value[,key=value] cell carries the key.
+// Keys are matched on pair boundaries since values may hold commas ie json.
+func hasKey(s, key string) bool {
+	for _, p := range strings.Split(s, ",") {
+		if k, _, ok := strings.Cut(p, "="); ok && k == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchKV checks if the given labels carry all the key/value pairs.
+func matchKV(labels, kvs map[string]string) bool {
+	for k, v := range kvs {
+		lv, ok := labels[k]
+		if !ok || !matchWildcard(v, lv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchWildcard checks if s matches the pattern where * matches any run of characters.
+func matchWildcard(pattern, s string) bool {
+	if !strings.Contains(pattern, "*") {
+		return pattern == s
+	}
+	rx, err := rxCache.compile(`\A` + strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, `.*`) + `\z`)
+	if err != nil {
+		return false
+	}
+
+	return rx.MatchString(s)
+}
+
+// cellValue parses a cell as a quantity or a number.
+func cellValue(s string) (float64, bool) {
+	s = strings.TrimSuffix(strings.ReplaceAll(strings.TrimSpace(s), ",", ""), "%")
+	if q, err := resource.ParseQuantity(s); err == nil {
+		return q.AsApproximateFloat64(), true
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return f, true
+	}
+
+	return 0, false
+}
+
+// compareCells orders cells numerically when possible, numbers first, then naturally.
+// Blank cells always come last.
+func compareCells(s1, s2 string, desc bool) int {
+	switch b1, b2 := isBlankCell(s1), isBlankCell(s2); {
+	case b1 && b2:
+		return 0
+	case b1:
+		return 1
+	case b2:
+		return -1
+	}
+	v1, ok1 := cellValue(s1)
+	v2, ok2 := cellValue(s2)
+	var c int
+	switch {
+	case ok1 && ok2:
+		c = cmp.Compare(v1, v2)
+	case ok1:
+		return -1
+	case ok2:
+		return 1
+	case s1 != s2:
+		c = 1
+		if sortorder.NaturalLess(s1, s2) {
+			c = -1
+		}
+	}
+	if desc {
+		return -c
+	}
+
+	return c
+}
+
+// parseAge parses a duration spec supporting time.ParseDuration units plus d for days ie 2d3h.
+func parseAge(s string) (time.Duration, error) {
+	days, rest, ok := strings.Cut(s, "d")
+	if !ok {
+		return time.ParseDuration(s)
+	}
+	n, err := strconv.ParseFloat(days, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid age %q", s)
+	}
+	d := time.Duration(n * float64(24*time.Hour))
+	if rest == "" {
+		return d, nil
+	}
+	r, err := time.ParseDuration(rest)
+	if err != nil || r < 0 {
+		return 0, fmt.Errorf("invalid age %q", s)
+	}
+
+	return d + r, nil
+}
+
+// humanAge converts a rendered age to a duration.
+func humanAge(s string) (time.Duration, bool) {
+	if !humanAgeRx.MatchString(s) {
+		return 0, false
+	}
+
+	return time.Duration(durationToSeconds(s)) * time.Second, true
+}
+
 func durationToSeconds(duration string) int64 {
 	if duration == "" {
 		return 0
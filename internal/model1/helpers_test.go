@@ -6,6 +6,7 @@ package model1
 import (
 	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -125,6 +126,37 @@ func TestDurationToSecond(t *testing.T) {
 	}
 }
 
+func TestParseAge(t *testing.T) {
+	uu := map[string]struct {
+		s   string
+		e   time.Duration
+		err bool
+	}{
+		"seconds":   {s: "30s", e: 30 * time.Second},
+		"hours":     {s: "1h30m", e: 90 * time.Minute},
+		"days":      {s: "2d", e: 48 * time.Hour},
+		"day_hours": {s: "2d3h", e: 51 * time.Hour},
+		"half_day":  {s: "0.5d", e: 12 * time.Hour},
+		"bad":       {s: "fred", err: true},
+		"bad_days":  {s: "xd", err: true},
+		"bad_rest":  {s: "2dfred", err: true},
+		"negative":  {s: "-1d", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			d, err := parseAge(u.s)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, d)
+		})
+	}
+}
+
 func BenchmarkDurationToSecond(b *testing.B) {
 	t := "2d22h3m50s"
 
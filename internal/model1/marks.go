@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"slices"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// rowMarks tracks marked row IDs. It is shared across a table copies so marks
+// survive refreshes and filtering.
+type rowMarks struct {
+	ids sets.Set[string]
+	mx  sync.RWMutex
+}
+
+func (m *rowMarks) mark(id string) {
+	if m == nil {
+		return
+	}
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	if m.ids == nil {
+		m.ids = sets.New[string]()
+	}
+	m.ids.Insert(id)
+}
+
+func (m *rowMarks) unmark(ids ...string) {
+	if m == nil {
+		return
+	}
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	m.ids.Delete(ids...)
+}
+
+func (m *rowMarks) has(id string) bool {
+	if m == nil {
+		return false
+	}
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+
+	return m.ids.Has(id)
+}
+
+// list returns the marked IDs in sorted order.
+func (m *rowMarks) list() []string {
+	if m == nil {
+		return nil
+	}
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+
+	ids := m.ids.UnsortedList()
+	slices.Sort(ids)
+
+	return ids
+}
+
+func (m *rowMarks) clear() {
+	if m == nil {
+		return
+	}
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	clear(m.ids)
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/slogs"
+)
+
+// profileTableEnv enables table filter/sort timings when set.
+const profileTableEnv = "K9S_PROFILE_TABLE"
+
+var profileTable = os.Getenv(profileTableEnv) != ""
+
+// timeOp starts timing a table operation on the given number of rows. The returned
+// function logs the resource, rows counts, elapsed time and any extra attributes
+// at debug level. It is a no-op unless K9S_PROFILE_TABLE is set and debug logging
+// is enabled.
+func timeOp(op string, gvr *client.GVR, rows int) func(out int, args ...any) {
+	if !profileTable || !slog.Default().Enabled(context.Background(), slog.LevelDebug) {
+		return func(int, ...any) {}
+	}
+	start := time.Now()
+
+	return func(out int, args ...any) {
+		attrs := []any{
+			slogs.Op, op,
+			slogs.GVR, gvr,
+			slogs.RowsIn, rows,
+			slogs.RowsOut, out,
+			slogs.Duration, time.Since(start),
+		}
+		slog.Debug("Table op timing", append(attrs, args...)...)
+	}
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeOp(t *testing.T) {
+	uu := map[string]struct {
+		info, off bool
+		run       func(*TableData)
+		e         []string
+	}{
+		"disabled": {
+			off: true,
+			run: func(td *TableData) { td.Filter(FilterOpts{Filter: "fred"}) },
+		},
+		"info": {
+			info: true,
+			run:  func(td *TableData) { td.Filter(FilterOpts{Filter: "fred"}) },
+		},
+		"rx": {
+			run: func(td *TableData) { td.Filter(FilterOpts{Filter: "fred"}) },
+			e:   []string{"op=filter", "gvr=v1/pods", "rows-in=3", "rows-out=1", "filter-mode=rx"},
+		},
+		"fuzzy": {
+			run: func(td *TableData) { td.Filter(FilterOpts{Filter: "-f bl"}) },
+			e:   []string{"op=filter", "rows-in=3", "rows-out=1", "filter-mode=fuzzy"},
+		},
+		"label": {
+			run: func(td *TableData) { td.Filter(FilterOpts{Filter: "-l app=fred"}) },
+			e:   []string{"op=filter", "rows-in=3", "rows-out=3", "filter-mode=label"},
+		},
+		"sort": {
+			run: func(td *TableData) { td.Sort(SortColumn{Name: "NAME"}) },
+			e:   []string{"op=sort", "rows-in=3", "rows-out=3", "sort-col=NAME:desc"},
+		},
+	}
+
+	defer func(p bool, l *slog.Logger) {
+		profileTable = p
+		slog.SetDefault(l)
+	}(profileTable, slog.Default())
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var out bytes.Buffer
+			level := slog.LevelDebug
+			if u.info {
+				level = slog.LevelInfo
+			}
+			slog.SetDefault(slog.New(slog.NewTextHandler(&out, &slog.HandlerOptions{Level: level})))
+			profileTable = !u.off
+
+			td := NewTableDataWithRows(
+				client.NewGVR("v1/pods"),
+				Header{HeaderColumn{Name: "NAME"}},
+				NewRowEventsWithEvts(
+					RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred"}}},
+					RowEvent{Row: Row{ID: "blee", Fields: Fields{"blee"}}},
+					RowEvent{Row: Row{ID: "zorg", Fields: Fields{"zorg"}}},
+				),
+			)
+			u.run(td)
+			if len(u.e) == 0 {
+				assert.NotContains(t, out.String(), "Table op")
+				return
+			}
+			assert.Equal(t, 1, strings.Count(out.String(), `msg="Table op timing"`))
+			for _, e := range u.e {
+				assert.Contains(t, out.String(), e)
+			}
+		})
+	}
+}
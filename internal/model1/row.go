@@ -3,6 +3,8 @@
 
 package model1
 
+import "slices"
+
 // Row represents a collection of columns.
 type Row struct {
 	ID     string
@@ -90,3 +92,8 @@ func (s RowSorter) Less(i, j int) bool {
 
 // ----------------------------------------------------------------------------
 // Helpers...
+
+// sameRow checks if two rows carry the same ID and fields.
+func sameRow(r1, r2 Row) bool {
+	return r1.ID == r2.ID && slices.Equal(r1.Fields, r2.Fields)
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+// RowEqualFn checks if two revisions of a row should be considered unchanged.
+type RowEqualFn func(a, b Row) bool
+
+var rowEqualFns = struct {
+	fns map[string]RowEqualFn
+	mx  sync.RWMutex
+}{
+	fns: make(map[string]RowEqualFn),
+}
+
+// RegisterRowEqualFn registers a custom row equality check for the given resource.
+// A nil function reverts to the default delta based comparison.
+func RegisterRowEqualFn(gvr *client.GVR, fn RowEqualFn) {
+	rowEqualFns.mx.Lock()
+	defer rowEqualFns.mx.Unlock()
+
+	if fn == nil {
+		delete(rowEqualFns.fns, gvr.String())
+		return
+	}
+	rowEqualFns.fns[gvr.String()] = fn
+}
+
+func rowEqualFor(gvr *client.GVR) (RowEqualFn, bool) {
+	if gvr == nil {
+		return nil, false
+	}
+	rowEqualFns.mx.RLock()
+	defer rowEqualFns.mx.RUnlock()
+
+	fn, ok := rowEqualFns.fns[gvr.String()]
+
+	return fn, ok
+}
@@ -6,7 +6,12 @@ package model1
 import (
 	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
 	"sort"
+	"strings"
+
+	"github.com/fvbommel/sortorder"
 )
 
 type ReRangeFn func(int, RowEvent) bool
@@ -19,6 +24,12 @@ type RowEvent struct {
 	Kind   ResEvent
 	Row    Row
 	Deltas DeltaRow
+	// Matches tracks the column indices that matched a filter if any.
+	Matches []int
+	// Decorations tracks semantic cell cues keyed by column index if any.
+	Decorations map[int]Decoration
+	// Group tracks the row group value when the table is grouped.
+	Group string
 }
 
 // NewRowEvent returns a new row event.
@@ -41,9 +52,12 @@ func NewRowEventWithDeltas(row Row, delta DeltaRow) RowEvent {
 // Clone returns a row event deep copy.
 func (r RowEvent) Clone() RowEvent {
 	return RowEvent{
-		Kind:   r.Kind,
-		Row:    r.Row.Clone(),
-		Deltas: r.Deltas.Clone(),
+		Kind:        r.Kind,
+		Row:         r.Row.Clone(),
+		Deltas:      r.Deltas.Clone(),
+		Matches:     slices.Clone(r.Matches),
+		Decorations: maps.Clone(r.Decorations),
+		Group:       r.Group,
 	}
 }
 
@@ -56,12 +70,41 @@ func (r RowEvent) Customize(cols []int) RowEvent {
 	}
 
 	return RowEvent{
-		Kind:   r.Kind,
-		Deltas: delta,
-		Row:    r.Row.Customize(cols),
+		Kind:        r.Kind,
+		Deltas:      delta,
+		Row:         r.Row.Customize(cols),
+		Matches:     customizeMatches(r.Matches, cols),
+		Decorations: customizeDecorations(r.Decorations, cols),
+		Group:       r.Group,
 	}
 }
 
+// HasMatch returns true if the given column index matched a filter.
+func (r RowEvent) HasMatch(col int) bool {
+	return slices.Contains(r.Matches, col)
+}
+
+// Decoration returns the decoration for the given column index if any.
+func (r RowEvent) Decoration(col int) (Decoration, bool) {
+	d, ok := r.Decorations[col]
+
+	return d, ok
+}
+
+func customizeMatches(mm, cols []int) []int {
+	if len(mm) == 0 {
+		return nil
+	}
+	out := make([]int, 0, len(mm))
+	for i, c := range cols {
+		if slices.Contains(mm, c) {
+			out = append(out, i)
+		}
+	}
+
+	return out
+}
+
 // ExtractHeaderLabels extract collection of fields into header.
 func (r RowEvent) ExtractHeaderLabels(labelCol int) []string {
 	hh, _ := sortLabels(labelize(r.Row.Fields[labelCol]))
@@ -182,6 +225,15 @@ func (r *RowEvents) Diff(re *RowEvents, ageCol int) bool {
 	return false
 }
 
+// ShallowClone returns a copy of the events collection. Rows, deltas and decorations
+// are shared with the original and must be treated as read-only.
+func (r *RowEvents) ShallowClone() *RowEvents {
+	return &RowEvents{
+		events: slices.Clone(r.events),
+		index:  maps.Clone(r.index),
+	}
+}
+
 // Clone returns a deep copy.
 func (r *RowEvents) Clone() *RowEvents {
 	re := make([]RowEvent, 0, len(r.events))
@@ -192,6 +244,15 @@ func (r *RowEvents) Clone() *RowEvents {
 	return NewRowEventsWithEvts(re...)
 }
 
+// Head returns a new collection with the first n events.
+func (r *RowEvents) Head(n int) *RowEvents {
+	if n > len(r.events) {
+		n = len(r.events)
+	}
+
+	return NewRowEventsWithEvts(r.events[:n]...)
+}
+
 // Upsert add or update a row if it exists.
 func (r *RowEvents) Upsert(re RowEvent) {
 	if idx, ok := r.FindIndex(re.Row.ID); ok {
@@ -305,6 +366,15 @@ func (r RowEventSorter) Swap(i, j int) {
 func (r RowEventSorter) Less(i, j int) bool {
 	f1, f2 := r.Events.events[i].Row.Fields, r.Events.events[j].Row.Fields
 	id1, id2 := r.Events.events[i].Row.ID, r.Events.events[j].Row.ID
+	// Blank cells always sort last regardless of the sort direction.
+	switch n1, n2 := isBlankCell(f1[r.Index]), isBlankCell(f2[r.Index]); {
+	case n1 && n2:
+		return sortorder.NaturalLess(id1, id2)
+	case n1:
+		return false
+	case n2:
+		return true
+	}
 	less := Less(r.IsNumber, r.IsDuration, r.IsCapacity, id1, id2, f1[r.Index], f2[r.Index])
 	if r.Asc {
 		return less
@@ -312,3 +382,13 @@ func (r RowEventSorter) Less(i, j int) bool {
 
 	return !less
 }
+
+// isBlankCell checks if a cell holds no actual value.
+func isBlankCell(s string) bool {
+	switch strings.TrimSpace(s) {
+	case "", NAValue, "n/a", "<none>":
+		return true
+	default:
+		return false
+	}
+}
@@ -542,3 +542,48 @@ func makeRowEvents() *model1.RowEvents {
 		model1.RowEvent{Row: model1.Row{ID: "ns2/C", Fields: model1.Fields{"C", "2", "3"}}},
 	)
 }
+
+func TestRowEventsSortBlanksLast(t *testing.T) {
+	uu := map[string]struct {
+		num, asc bool
+		e        []string
+	}{
+		"asc": {
+			asc: true,
+			e:   []string{"B", "D", "A", "C", "E", "F"},
+		},
+		"desc": {
+			e: []string{"D", "B", "A", "C", "E", "F"},
+		},
+		"num-asc": {
+			num: true,
+			asc: true,
+			e:   []string{"B", "D", "A", "C", "E", "F"},
+		},
+		"num-desc": {
+			num: true,
+			e:   []string{"D", "B", "A", "C", "E", "F"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			re := model1.NewRowEventsWithEvts(
+				model1.RowEvent{Row: model1.Row{ID: "A", Fields: model1.Fields{""}}},
+				model1.RowEvent{Row: model1.Row{ID: "B", Fields: model1.Fields{"10"}}},
+				model1.RowEvent{Row: model1.Row{ID: "C", Fields: model1.Fields{"<none>"}}},
+				model1.RowEvent{Row: model1.Row{ID: "D", Fields: model1.Fields{"200"}}},
+				model1.RowEvent{Row: model1.Row{ID: "E", Fields: model1.Fields{"n/a"}}},
+				model1.RowEvent{Row: model1.Row{ID: "F", Fields: model1.Fields{model1.NAValue}}},
+			)
+			re.Sort("", 0, false, u.num, false, u.asc)
+			ids := make([]string, 0, re.Len())
+			re.Range(func(_ int, e model1.RowEvent) bool {
+				ids = append(ids, e.Row.ID)
+				return true
+			})
+			assert.Equal(t, u.e, ids)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"container/list"
+	"regexp"
+	"sync"
+)
+
+const rxCacheSize = 32
+
+var rxCache = newRxLRU(rxCacheSize)
+
+type rxEntry struct {
+	key string
+	rx  *regexp.Regexp
+}
+
+// rxLRU tracks a bounded set of compiled regexes.
+type rxLRU struct {
+	size  int
+	ll    *list.List
+	items map[string]*list.Element
+	mx    sync.Mutex
+}
+
+func newRxLRU(size int) *rxLRU {
+	return &rxLRU{
+		size:  size,
+		ll:    list.New(),
+		items: make(map[string]*list.Element, size),
+	}
+}
+
+// compile returns a cached compiled regex for the given expression or compiles it.
+func (c *rxLRU) compile(expr string) (*regexp.Regexp, error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if e, ok := c.items[expr]; ok {
+		c.ll.MoveToFront(e)
+		return e.Value.(*rxEntry).rx, nil
+	}
+	rx, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	c.items[expr] = c.ll.PushFront(&rxEntry{key: expr, rx: rx})
+	if c.ll.Len() > c.size {
+		if e := c.ll.Back(); e != nil {
+			c.ll.Remove(e)
+			delete(c.items, e.Value.(*rxEntry).key)
+		}
+	}
+
+	return rx, nil
+}
+
+// rxMemo remembers the last regex compiled for a table so repeated filters on
+// refresh skip the shared cache altogether. It is shared across a table copies.
+type rxMemo struct {
+	expr string
+	rx   *regexp.Regexp
+	mx   sync.Mutex
+}
+
+// compile returns the memoized regex if the expression is unchanged or
+// resolves it from the shared cache otherwise.
+func (m *rxMemo) compile(expr string) (*regexp.Regexp, error) {
+	if m == nil {
+		return rxCache.compile(expr)
+	}
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	if m.rx != nil && m.expr == expr {
+		return m.rx, nil
+	}
+	rx, err := rxCache.compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	m.expr, m.rx = expr, rx
+
+	return rx, nil
+}
+
+func (c *rxLRU) len() int {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return c.ll.Len()
+}
@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRxLRUCompile(t *testing.T) {
+	c := newRxLRU(2)
+
+	rx1, err := c.compile("fred")
+	require.NoError(t, err)
+	rx2, err := c.compile("fred")
+	require.NoError(t, err)
+	assert.Same(t, rx1, rx2)
+
+	_, err = c.compile("(blee")
+	require.Error(t, err)
+	assert.Equal(t, 1, c.len())
+}
+
+func TestRxLRUEvict(t *testing.T) {
+	c := newRxLRU(2)
+
+	a, _ := c.compile("a")
+	_, _ = c.compile("b")
+	a1, _ := c.compile("a")
+	assert.Same(t, a, a1)
+
+	_, _ = c.compile("c")
+	assert.Equal(t, 2, c.len())
+	_, ok := c.items["b"]
+	assert.False(t, ok)
+	a2, _ := c.compile("a")
+	assert.Same(t, a, a2)
+}
+
+func TestRxLRUConcurrent(t *testing.T) {
+	c := newRxLRU(4)
+
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := c.compile(fmt.Sprintf("rx-%d", i%8))
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 4, c.len())
+}
+
+func TestRxMemoCompile(t *testing.T) {
+	var m rxMemo
+
+	rx1, err := m.compile("(?i)(fred)")
+	require.NoError(t, err)
+	rx2, err := m.compile("(?i)(fred)")
+	require.NoError(t, err)
+	assert.Same(t, rx1, rx2)
+
+	rx3, err := m.compile("(fred)")
+	require.NoError(t, err)
+	assert.NotSame(t, rx1, rx3)
+	assert.Equal(t, "(fred)", m.expr)
+
+	_, err = m.compile("(blee")
+	require.Error(t, err)
+	assert.Same(t, rx3, m.rx)
+
+	var nilMemo *rxMemo
+	rx4, err := nilMemo.compile("(?i)(fred)")
+	require.NoError(t, err)
+	assert.Same(t, rx1, rx4)
+}
+
+func TestTableDataRxMemoShared(t *testing.T) {
+	td := NewTableData(client.NewGVR("v1/pods"))
+
+	assert.Same(t, td.rx, td.Clone().rx)
+	assert.Same(t, td.rx, td.Snapshot().rx)
+	assert.Same(t, td.rx, NewTableDataFromTable(td).rx)
+}
+
+func BenchmarkRxFilterCompile(b *testing.B) {
+	const expr = `(?i)(fred|blee-\d+)`
+
+	b.Run("compile", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			_, _ = regexp.Compile(expr)
+		}
+	})
+	b.Run("lru", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			_, _ = rxCache.compile(expr)
+		}
+	})
+	b.Run("memo", func(b *testing.B) {
+		var m rxMemo
+		b.ReportAllocs()
+		for range b.N {
+			_, _ = m.compile(expr)
+		}
+	})
+}
@@ -7,21 +7,39 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"log/slog"
+	"maps"
 	"regexp"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/tview"
+	"github.com/fvbommel/sortorder"
 	"github.com/sahilm/fuzzy"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
+// errNilGVR signals a table was built without a resource.
+var errNilGVR = errors.New("table data requires a non-nil GVR")
+
+// clock returns the current time.
+var clock = time.Now
+
 // SortFn represent a function that can sort columnar data.
 type SortFn func(rows Rows, sortCol SortColumn)
 
@@ -36,12 +54,198 @@ func (s SortColumn) IsSet() bool {
 	return s.Name != ""
 }
 
-const spacer = " "
+// String returns the sort column spec ie col-name:asc|desc.
+func (s SortColumn) String() string {
+	if s.ASC {
+		return s.Name + ":" + sortASC
+	}
+
+	return s.Name + ":" + sortDESC
+}
+
+// ParseSortColumn parses a col-name[:asc|desc] sort column spec. The direction defaults to asc.
+func ParseSortColumn(spec string) (SortColumn, error) {
+	name, dir, ok := strings.Cut(spec, ":")
+	name = strings.TrimSpace(name)
+	if name == "" || strings.Contains(dir, ":") {
+		return SortColumn{}, fmt.Errorf("invalid sort column spec: %q. must be col-name[:asc|desc]", spec)
+	}
+	if !ok {
+		return SortColumn{Name: name, ASC: true}, nil
+	}
+	switch strings.ToLower(strings.TrimSpace(dir)) {
+	case sortASC:
+		return SortColumn{Name: name, ASC: true}, nil
+	case sortDESC:
+		return SortColumn{Name: name}, nil
+	default:
+		return SortColumn{}, fmt.Errorf("invalid sort direction %q. must be one of %s or %s", dir, sortASC, sortDESC)
+	}
+}
+
+const (
+	sortASC  = "asc"
+	sortDESC = "desc"
+)
+
+// spacer joins row fields for matching. The ASCII unit separator never occurs in a
+// cell so matches can not span field boundaries. NUL is avoided as fuzzy treats it as
+// the end of input.
+const spacer = "\x1f"
+
+// Filter modes.
+const (
+	filterModeNone  = "none"
+	filterModeAge   = "age"
+	filterModeKV    = "kv"
+	filterModeLabel = "label"
+	filterModeFuzzy = "fuzzy"
+	filterModeRX    = "rx"
+	filterModeCol   = "col"
+	filterModeHas   = "has"
+)
+
+// Fingerprint separators.
+const (
+	fieldSep byte = iota
+	rowSep
+)
+
+// RowChanges tracks the row ids affected by a table update.
+type RowChanges struct {
+	Added, Updated, Deleted []string
+}
+
+// IsEmpty checks if no rows changed.
+func (r RowChanges) IsEmpty() bool {
+	return len(r.Added) == 0 && len(r.Updated) == 0 && len(r.Deleted) == 0
+}
+
+// Counts returns the changes tallies.
+func (r RowChanges) Counts() UpdateCounts {
+	return UpdateCounts{
+		Added:   len(r.Added),
+		Changed: len(r.Updated),
+		Deleted: len(r.Deleted),
+	}
+}
 
+// UpdateCounts tracks the number of rows affected by a table update.
+type UpdateCounts struct {
+	Added, Changed, Deleted int
+}
+
+// FilterOpts represents table filtering options.
 type FilterOpts struct {
-	Toast  bool
+	// Toast only keeps invalid rows.
+	Toast bool
+
+	// Filter represents a regex, fuzzy or label query.
 	Filter string
+
+	// Invert only keeps rows not matching a regex filter.
 	Invert bool
+
+	// Limit caps the number of filtered rows. Zero means unlimited.
+	Limit int
+
+	// ChangedOnly only keeps rows that changed during the last update.
+	ChangedOnly bool
+
+	// FuzzyOnFields fuzzy matches on the visible columns rather than the row ID.
+	FuzzyOnFields bool
+
+	// ClientLabels evaluates label selectors against the rendered LABELS column
+	// rather than deferring to the server side selection.
+	ClientLabels bool
+}
+
+// NewFilterOpts returns filter options from a saved filter setting.
+func NewFilterOpts(f config.FilterSetting) FilterOpts {
+	return FilterOpts{
+		Filter:        f.Filter,
+		Invert:        f.Invert,
+		Toast:         f.Toast,
+		ChangedOnly:   f.ChangedOnly,
+		Limit:         f.Limit,
+		FuzzyOnFields: f.FuzzyOnFields,
+		ClientLabels:  f.ClientLabels,
+	}
+}
+
+// Setting returns the filter options as a saved filter setting.
+func (f FilterOpts) Setting() config.FilterSetting {
+	return config.FilterSetting{
+		Filter:        f.Filter,
+		Invert:        f.Invert,
+		Toast:         f.Toast,
+		ChangedOnly:   f.ChangedOnly,
+		Limit:         f.Limit,
+		FuzzyOnFields: f.FuzzyOnFields,
+		ClientLabels:  f.ClientLabels,
+	}
+}
+
+// ComputeFn computes a virtual column field from a row.
+type ComputeFn func(row Row) string
+
+type computedColumn struct {
+	name string
+	fn   ComputeFn
+}
+
+// Alignment represents a column alignment.
+type Alignment int
+
+const (
+	// AlignLeft left aligns a column.
+	AlignLeft Alignment = iota
+
+	// AlignRight right aligns a column.
+	AlignRight
+)
+
+// String returns the alignment name.
+func (a Alignment) String() string {
+	if a == AlignRight {
+		return "right"
+	}
+
+	return "left"
+}
+
+// ColumnAlignments returns the column alignments. Numeric, capacity, metric and
+// time columns right align while other columns left align.
+func (t *TableData) ColumnAlignments(wide bool) []Alignment {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	aa := make([]Alignment, 0, len(t.header))
+	for _, h := range t.header {
+		if !wide && h.Wide {
+			continue
+		}
+		aa = append(aa, columnAlignment(h))
+	}
+
+	return aa
+}
+
+func columnAlignment(h HeaderColumn) Alignment {
+	if h.Align == tview.AlignRight || h.MX || h.Capacity || h.Time {
+		return AlignRight
+	}
+
+	return AlignLeft
+}
+
+// ColumnMeta describes a table column.
+type ColumnMeta struct {
+	Name     string
+	Wide     bool
+	Time     bool
+	MX       bool
+	Capacity bool
 }
 
 // TableData tracks a K8s resource for tabular display.
@@ -50,7 +254,28 @@ type TableData struct {
 	rowEvents *RowEvents
 	namespace string
 	gvr       *client.GVR
-	mx        sync.RWMutex
+	versions  map[string]string
+	matches   int
+	computed  []computedColumn
+	decorate  DecorateFn
+	aliases   map[string]string
+	// tombstones tracks the rows deleted by the last update.
+	tombstones []RowEvent
+	// rx memoizes the last filter regex across refreshes.
+	rx *rxMemo
+	// groupBy tracks the column rows are grouped by if any.
+	groupBy string
+	// retention tracks how long deleted rows linger before being removed.
+	retention time.Duration
+	// deletedAt tracks when lingering rows were deleted.
+	deletedAt map[string]time.Time
+	// source tracks the unfiltered table this table was filtered from if any.
+	source *TableData
+	// marks tracks the marked rows across refreshes and filters.
+	marks *rowMarks
+	// colIdx caches the header filter column indices.
+	colIdx *colIndexCache
+	mx     sync.RWMutex
 }
 
 // NewTableData returns a new table.
@@ -58,6 +283,9 @@ func NewTableData(gvr *client.GVR) *TableData {
 	return &TableData{
 		gvr:       gvr,
 		rowEvents: NewRowEvents(10),
+		rx:        new(rxMemo),
+		marks:     new(rowMarks),
+		colIdx:    new(colIndexCache),
 	}
 }
 
@@ -75,15 +303,212 @@ func NewTableDataWithRows(gvr *client.GVR, h Header, re *RowEvents) *TableData {
 	return t
 }
 
+// NewTableDataFromTable returns a table sharing the given table header and rows.
+// Updates to either table are visible to the other so callers needing a stable
+// copy should use Clone or Snapshot instead.
 func NewTableDataFromTable(td *TableData) *TableData {
 	t := NewTableData(td.gvr)
 	t.header = td.header
 	t.rowEvents = td.rowEvents
 	t.namespace = td.namespace
+	t.aliases = maps.Clone(td.aliases)
+	t.rx = td.rx
+	t.groupBy = td.groupBy
+	t.marks = td.marks
+	t.colIdx = td.colIdx
 
 	return t
 }
 
+// RenameColumn aliases a column display name. Sorting and filtering still resolve
+// columns by their canonical name. An empty display name clears the alias.
+func (t *TableData) RenameColumn(canonical, display string) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	if display == "" || display == canonical {
+		delete(t.aliases, canonical)
+		return
+	}
+	if t.aliases == nil {
+		t.aliases = make(map[string]string)
+	}
+	t.aliases[canonical] = display
+}
+
+// DisplayNames returns the column names as displayed, honoring aliases.
+func (t *TableData) DisplayNames(wide bool) []string {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	cc := t.header.ColumnNames(wide)
+	for i, c := range cc {
+		cc[i] = t.displayName(c)
+	}
+
+	return cc
+}
+
+func (t *TableData) displayName(canonical string) string {
+	if n, ok := t.aliases[canonical]; ok {
+		return n
+	}
+
+	return canonical
+}
+
+// AddComputedColumn appends a virtual column whose fields are computed from each row.
+// Computed columns are recomputed on every update.
+func (t *TableData) AddComputedColumn(name string, fn ComputeFn) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	base := max(len(t.header)-len(t.computed), 0)
+	t.computed = append(t.computed, computedColumn{name: name, fn: fn})
+	t.header, t.colIdx = t.withComputed(t.header[:base:base]), new(colIndexCache)
+	for i := range t.rowEvents.Len() {
+		if ev, ok := t.rowEvents.At(i); ok {
+			ev.Row = t.compute(ev.Row, base)
+			t.rowEvents.Set(i, ev)
+		}
+	}
+}
+
+// SetDecorateFn registers a function computing per cell decorations for each row.
+// Decorations are recomputed on every update.
+func (t *TableData) SetDecorateFn(fn DecorateFn) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.decorate = fn
+	for i := range t.rowEvents.Len() {
+		if ev, ok := t.rowEvents.At(i); ok {
+			t.rowEvents.Set(i, t.decorated(ev))
+		}
+	}
+}
+
+// GroupBy tags each row with its value for the given column so rows can be
+// displayed in groups. An empty column name clears the grouping.
+func (t *TableData) GroupBy(colName string) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.groupBy = colName
+	t.regroup()
+}
+
+// regroup retags all rows with their group value.
+func (t *TableData) regroup() {
+	idx := t.groupIndex()
+	for i := range t.rowEvents.Len() {
+		if ev, ok := t.rowEvents.At(i); ok {
+			ev.Group = groupOf(ev.Row, idx)
+			t.rowEvents.Set(i, ev)
+		}
+	}
+}
+
+// groupIndex returns the grouping column index or -1 if the table is not grouped.
+func (t *TableData) groupIndex() int {
+	if t.groupBy == "" {
+		return -1
+	}
+	idx, ok := t.header.IndexOf(t.groupBy, true)
+	if !ok {
+		return -1
+	}
+
+	return idx
+}
+
+func groupOf(r Row, idx int) string {
+	if idx < 0 || idx >= len(r.Fields) {
+		return ""
+	}
+
+	return r.Fields[idx]
+}
+
+// Groups returns the sorted distinct group values of a grouped table.
+func (t *TableData) Groups() []string {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	if t.groupIndex() < 0 {
+		return nil
+	}
+	gg := sets.New[string]()
+	t.rowEvents.Range(func(_ int, re RowEvent) bool {
+		gg.Insert(re.Group)
+		return true
+	})
+	groups := gg.UnsortedList()
+	sort.Sort(sortorder.Natural(groups))
+
+	return groups
+}
+
+// RowsInGroup returns a copy of the rows in the given group in the table order.
+func (t *TableData) RowsInGroup(g string) []RowEvent {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	if t.groupIndex() < 0 {
+		return nil
+	}
+	var rr []RowEvent
+	t.rowEvents.Range(func(_ int, re RowEvent) bool {
+		if re.Group == g {
+			rr = append(rr, re)
+		}
+		return true
+	})
+
+	return rr
+}
+
+// decorated returns the row event with its cell decorations and group set.
+func (t *TableData) decorated(ev RowEvent) RowEvent {
+	ev.Group = groupOf(ev.Row, t.groupIndex())
+	if t.decorate == nil {
+		ev.Decorations = nil
+		return ev
+	}
+	ev.Decorations = t.decorate(ev.Row)
+
+	return ev
+}
+
+// withComputed returns a header with the computed columns appended.
+func (t *TableData) withComputed(h Header) Header {
+	if len(t.computed) == 0 {
+		return h
+	}
+	hh := make(Header, 0, len(h)+len(t.computed))
+	hh = append(hh, h...)
+	for _, c := range t.computed {
+		hh = append(hh, HeaderColumn{Name: c.name})
+	}
+
+	return hh
+}
+
+// compute returns a row with the computed fields set past the given base fields count.
+func (t *TableData) compute(row Row, base int) Row {
+	if len(t.computed) == 0 || base > len(row.Fields) {
+		return row
+	}
+	src := Row{ID: row.ID, Fields: row.Fields[:base:base]}
+	ff := make(Fields, 0, base+len(t.computed))
+	ff = append(ff, src.Fields...)
+	for _, c := range t.computed {
+		ff = append(ff, c.fn(src))
+	}
+
+	return Row{ID: row.ID, Fields: ff}
+}
+
 func (t *TableData) AddRow(re RowEvent) {
 	t.rowEvents.Add(re)
 }
@@ -100,6 +525,290 @@ func (t *TableData) RowAt(idx int) (RowEvent, bool) {
 	return t.rowEvents.At(idx)
 }
 
+// RowByField returns the first row whose given column matches the value.
+func (t *TableData) RowByField(colName, value string) (RowEvent, bool) {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	idx, ok := t.header.IndexOf(colName, true)
+	if !ok {
+		return RowEvent{}, false
+	}
+	var (
+		row   RowEvent
+		found bool
+	)
+	t.rowEvents.Range(func(_ int, re RowEvent) bool {
+		if idx < len(re.Row.Fields) && re.Row.Fields[idx] == value {
+			row, found = re, true
+			return false
+		}
+		return true
+	})
+
+	return row, found
+}
+
+// CountByColumn tallies the distinct values of the given column across all rows.
+func (t *TableData) CountByColumn(colName string) (map[string]int, bool) {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	idx, ok := t.header.IndexOf(colName, true)
+	if !ok {
+		return nil, false
+	}
+	counts := make(map[string]int)
+	t.rowEvents.Range(func(_ int, re RowEvent) bool {
+		if idx < len(re.Row.Fields) {
+			counts[re.Row.Fields[idx]]++
+		}
+		return true
+	})
+
+	return counts, true
+}
+
+// Column returns the given column values across all rows in display order.
+func (t *TableData) Column(name string, wide bool) ([]string, bool) {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	idx, ok := t.header.IndexOf(name, wide)
+	if !ok {
+		return nil, false
+	}
+	vv := make([]string, 0, t.rowEvents.Len())
+	t.rowEvents.Range(func(_ int, re RowEvent) bool {
+		if idx < len(re.Row.Fields) {
+			vv = append(vv, re.Row.Fields[idx])
+		}
+		return true
+	})
+
+	return vv, true
+}
+
+// ReorderColumns moves the given columns first in order. Other columns retain
+// their relative order at the end.
+func (t *TableData) ReorderColumns(order []string) error {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	seen := make(map[int]struct{}, len(t.header))
+	cols := make([]int, 0, len(t.header))
+	for _, n := range order {
+		idx, ok := t.header.IndexOf(n, true)
+		if !ok {
+			return fmt.Errorf("no column named %q", n)
+		}
+		if _, ok := seen[idx]; ok {
+			return fmt.Errorf("duplicate column %q", n)
+		}
+		seen[idx] = struct{}{}
+		cols = append(cols, idx)
+	}
+	for i := range t.header {
+		if _, ok := seen[i]; !ok {
+			cols = append(cols, i)
+		}
+	}
+
+	h := make(Header, 0, len(cols))
+	for _, c := range cols {
+		h = append(h, t.header[c])
+	}
+	t.header, t.rowEvents = h, t.rowEvents.Customize(cols)
+	t.colIdx = new(colIndexCache)
+
+	return nil
+}
+
+// Merge appends the rows of the given tables, skipping rows already present, and
+// switches the table to all namespaces. Tables must share the same resource and header.
+func (t *TableData) Merge(others ...*TableData) error {
+	type snapshot struct {
+		gvr    *client.GVR
+		header Header
+		events *RowEvents
+	}
+	ss := make([]snapshot, 0, len(others))
+	for _, o := range others {
+		if o == nil || o == t {
+			continue
+		}
+		o.mx.RLock()
+		ss = append(ss, snapshot{gvr: o.gvr, header: o.header, events: o.rowEvents.Clone()})
+		o.mx.RUnlock()
+	}
+
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	for _, s := range ss {
+		if !sameGVR(s.gvr, t.gvr) {
+			return fmt.Errorf("unable to merge %s into %s", s.gvr, t.gvr)
+		}
+		if t.header.Diff(s.header) {
+			return fmt.Errorf("unable to merge %s tables with different headers", t.gvr)
+		}
+	}
+	for _, s := range ss {
+		s.events.Range(func(_ int, re RowEvent) bool {
+			if _, ok := t.rowEvents.FindIndex(re.Row.ID); !ok {
+				t.rowEvents.Add(re)
+			}
+			return true
+		})
+	}
+	t.namespace, t.versions = client.NamespaceAll, nil
+
+	return nil
+}
+
+func sameGVR(a, b *client.GVR) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.String() == b.String()
+}
+
+// Slice returns a copy of at most limit rows starting at offset in display order.
+func (t *TableData) Slice(offset, limit int) []RowEvent {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	n := t.rowEvents.Len()
+	if offset < 0 || offset >= n || limit <= 0 {
+		return nil
+	}
+	end := min(offset+limit, n)
+	ee := make([]RowEvent, 0, end-offset)
+	for i := offset; i < end; i++ {
+		ee = append(ee, t.rowEvents.events[i].Clone())
+	}
+
+	return ee
+}
+
+// Since returns a table holding the rows whose AGE is at or below the given duration.
+// Rows with no parseable age are left out.
+func (t *TableData) Since(d time.Duration) *TableData {
+	td := NewTableDataFromTable(t)
+	td.rowEvents = td.ageFilter("<=", d, false)
+
+	return td
+}
+
+// TopN returns a clone holding the n rows with the highest values in the given column,
+// or the lowest ones when desc is not set. Ties are broken by row ID. Blank cells are
+// ranked last, as are rows missing the column. An unknown column yields a table with no rows.
+func (t *TableData) TopN(colName string, n int, desc bool) *TableData {
+	td := t.Clone()
+	idx, ok := td.header.IndexOf(colName, true)
+	if !ok {
+		slog.Warn("TopN unknown column", slogs.ColName, colName)
+		td.rowEvents = NewRowEvents(0)
+		return td
+	}
+
+	cell := func(r Row) string {
+		if idx < len(r.Fields) {
+			return r.Fields[idx]
+		}
+		return ""
+	}
+	ee := slices.Clone(td.rowEvents.events)
+	slices.SortStableFunc(ee, func(a, b RowEvent) int {
+		if c := compareCells(cell(a.Row), cell(b.Row), desc); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Row.ID, b.Row.ID)
+	})
+	td.rowEvents = NewRowEventsWithEvts(ee[:min(max(n, 0), len(ee))]...)
+
+	return td
+}
+
+// Aggregate sums the given metric or capacity columns across the table rows and
+// returns the formatted totals keyed by column name. Capacity columns are summed
+// as resource quantities. Blank cells are skipped.
+func (t *TableData) Aggregate(cols []string) (map[string]string, error) {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	totals := make(map[string]string, len(cols))
+	for _, c := range cols {
+		idx, ok := t.header.IndexOf(c, true)
+		if !ok {
+			return nil, fmt.Errorf("unknown column %q", c)
+		}
+		var (
+			total string
+			err   error
+		)
+		switch h := t.header[idx]; {
+		case h.Capacity:
+			total, err = t.sumQuantities(idx)
+		case h.MX:
+			total, err = t.sumNumbers(idx)
+		default:
+			return nil, fmt.Errorf("column %q is not numeric", c)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("aggregate %q failed: %w", c, err)
+		}
+		totals[c] = total
+	}
+
+	return totals, nil
+}
+
+func (t *TableData) sumNumbers(idx int) (string, error) {
+	var (
+		sum float64
+		err error
+	)
+	t.rowEvents.Range(func(_ int, re RowEvent) bool {
+		if idx >= len(re.Row.Fields) || isBlankCell(re.Row.Fields[idx]) {
+			return true
+		}
+		f := re.Row.Fields[idx]
+		var v float64
+		if v, err = strconv.ParseFloat(strings.ReplaceAll(f, ",", ""), 64); err != nil {
+			err = fmt.Errorf("row %q: %w", re.Row.ID, err)
+			return false
+		}
+		sum += v
+		return true
+	})
+
+	return strconv.FormatFloat(sum, 'f', -1, 64), err
+}
+
+func (t *TableData) sumQuantities(idx int) (string, error) {
+	var (
+		sum resource.Quantity
+		err error
+	)
+	t.rowEvents.Range(func(_ int, re RowEvent) bool {
+		if idx >= len(re.Row.Fields) || isBlankCell(re.Row.Fields[idx]) {
+			return true
+		}
+		f := re.Row.Fields[idx]
+		var q resource.Quantity
+		if q, err = resource.ParseQuantity(f); err != nil {
+			err = fmt.Errorf("row %q: %w", re.Row.ID, err)
+			return false
+		}
+		sum.Add(q)
+		return true
+	})
+
+	return sum.String(), err
+}
+
 func (t *TableData) RowsRange(f ReRangeFn) {
 	t.rowEvents.Range(f)
 }
@@ -109,6 +818,8 @@ func (t *TableData) Sort(sc SortColumn) {
 	if idx < 0 {
 		return
 	}
+	done := timeOp("sort", t.gvr, t.RowCount())
+	defer func() { done(t.RowCount(), slogs.SortCol, sc.String()) }()
 	t.rowEvents.Sort(
 		t.GetNamespace(),
 		idx,
@@ -119,89 +830,452 @@ func (t *TableData) Sort(sc SortColumn) {
 	)
 }
 
-func (t *TableData) Header() Header {
-	return t.header
+// ColumnMeta returns a copy of the columns metadata in header order.
+func (t *TableData) ColumnMeta() []ColumnMeta {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	mm := make([]ColumnMeta, 0, len(t.header))
+	for _, h := range t.header {
+		mm = append(mm, ColumnMeta{
+			Name:     h.Name,
+			Wide:     h.Wide,
+			Time:     h.Time,
+			MX:       h.MX,
+			Capacity: h.Capacity,
+		})
+	}
+
+	return mm
+}
+
+func (t *TableData) Header() Header {
+	return t.header
+}
+
+// HeaderCount returns the number of header cols.
+func (t *TableData) HeaderCount() int {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	return len(t.header)
+}
+
+func (t *TableData) HeadCol(n string, w bool) (header HeaderColumn, idx int) {
+	idx, ok := t.header.IndexOf(n, w)
+	if !ok {
+		return HeaderColumn{}, -1
+	}
+
+	return t.header[idx], idx
+}
+
+// Filter returns a new table with the rows matching the given options.
+// Toast and changed rows filtering are applied first and the query then narrows down the remaining rows.
+// A regex query is inverted if either the query starts with ! or Invert is set.
+// When a limit is set, only the first matching rows are kept in match order.
+// Filters always apply to the unfiltered table so broadening a filter recovers hidden rows.
+func (t *TableData) Filter(f FilterOpts) *TableData {
+	src := t.Unfiltered()
+	done := timeOp("filter", src.gvr, src.RowCount())
+	td, mode := src.filter(f)
+	td.source = src
+	td.limit(f.Limit)
+	done(td.RowCount(), slogs.FilterMode, mode)
+
+	return td
+}
+
+// Unfiltered returns the full table this table was filtered from or the table itself
+// when it was not filtered.
+func (t *TableData) Unfiltered() *TableData {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	if t.source != nil {
+		return t.source
+	}
+
+	return t
+}
+
+// filter returns the filtered table along with the filter mode applied.
+func (t *TableData) filter(f FilterOpts) (*TableData, string) {
+	td := NewTableDataFromTable(t)
+
+	if f.Toast {
+		td.rowEvents = t.filterToast()
+	}
+	if f.ChangedOnly {
+		td.rowEvents = td.filterChanged()
+	}
+	if op, age, ok := internal.IsAgeSelector(strings.TrimPrefix(f.Filter, "!")); ok {
+		d, err := parseAge(age)
+		if err != nil {
+			slog.Error("Age filter failed", slogs.Error, err)
+			return td, filterModeAge
+		}
+		td.rowEvents = td.ageFilter(op, d, f.Invert || internal.IsInverseSelector(f.Filter))
+		return td, filterModeAge
+	}
+	if f.ClientLabels && internal.IsLabelSelector(f.Filter) {
+		if rr, ok := td.labelFilter(f.Filter); ok {
+			td.rowEvents = rr
+			return td, filterModeLabel
+		}
+	}
+	if key, ok := parseHasQuery(strings.TrimPrefix(f.Filter, "!")); ok {
+		if rr, ok := td.hasFilter(key, f.Invert || internal.IsInverseSelector(f.Filter)); ok {
+			td.rowEvents = rr
+			return td, filterModeHas
+		}
+	}
+	if kvs, ok := parseKVQuery(strings.TrimPrefix(f.Filter, "!")); ok {
+		if rr, ok := td.kvFilter(kvs, f.Invert || internal.IsInverseSelector(f.Filter)); ok {
+			td.rowEvents = rr
+			return td, filterModeKV
+		}
+	}
+	if f.Filter == "" {
+		return td, filterModeNone
+	}
+	q, inverse := f.Filter, f.Invert
+	if internal.IsInverseSelector(q) {
+		q, inverse = q[1:], true
+	}
+	// Column clauses go first so exclusions ie -labels:x are not mistaken for selectors.
+	if cc, ok := td.parseColQuery(q); ok {
+		rr, err := td.colFilter(cc, inverse)
+		if err == nil {
+			td.rowEvents = rr
+		} else {
+			slog.Error("Column filter failed", slogs.Error, err)
+		}
+		return td, filterModeCol
+	}
+	if internal.IsLabelSelector(f.Filter) {
+		return td, filterModeLabel
+	}
+	if q, ok := internal.IsFuzzySelector(f.Filter); ok {
+		td.rowEvents = td.fuzzyFilter(q, f.FuzzyOnFields)
+		return td, filterModeFuzzy
+	}
+	if x, ok := internal.IsExactSelector(q); ok {
+		q = `^(?:` + x + `)$`
+	}
+	rr, err := td.rxFilter(q, inverse)
+	if err == nil {
+		td.rowEvents = rr
+	} else {
+		slog.Error("RX filter failed", slogs.Error, err)
+	}
+
+	return td, filterModeRX
+}
+
+// SaveFilter saves the filter options under the given name for the table resource.
+func (t *TableData) SaveFilter(cf *config.CustomFilters, name string, f FilterOpts) error {
+	if t.gvr == nil {
+		return errors.New("no resource found to save filter")
+	}
+	cf.Set(t.gvr.String(), name, f.Setting())
+
+	return nil
+}
+
+// NamedFilters returns the saved filter names for the table resource.
+func (t *TableData) NamedFilters(cf *config.CustomFilters) []string {
+	if t.gvr == nil {
+		return nil
+	}
+
+	return cf.Names(t.gvr.String())
+}
+
+// FilterNamed applies a saved filter to the table.
+func (t *TableData) FilterNamed(cf *config.CustomFilters, name string) (*TableData, error) {
+	if t.gvr == nil {
+		return nil, errors.New("no resource found to apply filter")
+	}
+	f, ok := cf.Get(t.gvr.String(), name)
+	if !ok {
+		return nil, fmt.Errorf("no filter named %q for resource %s", name, t.gvr)
+	}
+
+	return t.Filter(NewFilterOpts(f)), nil
+}
+
+// limit caps the table rows and tracks the total matches if truncated.
+func (t *TableData) limit(n int) {
+	if n <= 0 || t.rowEvents.Len() <= n {
+		return
+	}
+	t.matches = t.rowEvents.Len()
+	t.rowEvents = t.rowEvents.Head(n)
+}
+
+// Truncated returns the total matches count and true if the rows were capped by a filter limit.
+func (t *TableData) Truncated() (int, bool) {
+	return t.matches, t.matches > 0
+}
+
+func (t *TableData) rxFilter(q string, inverse bool) (*RowEvents, error) {
+	if strings.Contains(q, " ") {
+		return t.rowEvents, nil
+	}
+
+	rx, err := t.rx.compile(`(?i)(` + q + `)`)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rx filter %q: %w", q, err)
+	}
+
+	vidx := t.colIdx.filterColIndices(t.header, t.namespace, true)
+	rr := NewRowEvents(t.RowCount() / 2)
+	t.rowEvents.Range(func(_ int, re RowEvent) bool {
+		var mm []int
+		for idx, f := range re.Row.Fields {
+			if vidx.Has(idx) && rx.MatchString(f) {
+				mm = append(mm, idx)
+			}
+		}
+		match := len(mm) > 0
+		if inverse && !match {
+			rr.Add(re)
+		}
+		if !inverse && match {
+			re.Matches = mm
+			rr.Add(re)
+		}
+
+		return true
+	})
+
+	return rr, nil
+}
+
+// colClause tracks a column scoped filter term ie status:Running or -node:ip-10-0.
+type colClause struct {
+	idx     int
+	q       string
+	exclude bool
+}
+
+// parseColQuery parses a query made of space separated col:rx clauses. A leading -
+// excludes rows matching the clause. Column names may span words ie last seen:5m.
+// It returns false if any term does not name a table column so regular queries
+// containing a colon are left alone.
+func (t *TableData) parseColQuery(q string) ([]colClause, bool) {
+	tt := strings.Fields(q)
+	if len(tt) == 0 {
+		return nil, false
+	}
+	cc := make([]colClause, 0, len(tt))
+	var pending []string
+	for _, term := range tt {
+		// Words without a value belong to a multi words column name ie last seen:x.
+		if !strings.Contains(term, ":") {
+			pending = append(pending, term)
+			continue
+		}
+		term = strings.Join(append(pending, term), " ")
+		pending = pending[:0]
+		body, exclude := strings.CutPrefix(term, "-")
+		col, v, ok := strings.Cut(body, ":")
+		if !ok || col == "" || v == "" {
+			return nil, false
+		}
+		idx, ok := t.header.IndexOf(strings.ToUpper(col), true)
+		if !ok {
+			return nil, false
+		}
+		cc = append(cc, colClause{idx: idx, q: v, exclude: exclude})
+	}
+	if len(pending) > 0 {
+		return nil, false
+	}
+
+	return cc, true
+}
+
+// colFilter keeps rows matching all the inclusion clauses and none of the
+// exclusion clauses. The whole result is inverted when inverse is set.
+func (t *TableData) colFilter(cc []colClause, inverse bool) (*RowEvents, error) {
+	rxs := make([]*regexp.Regexp, 0, len(cc))
+	for _, c := range cc {
+		rx, err := rxCache.compile(`(?i)(` + c.q + `)`)
+		if err != nil {
+			return nil, fmt.Errorf("invalid column filter %q: %w", c.q, err)
+		}
+		rxs = append(rxs, rx)
+	}
+
+	rr := NewRowEvents(t.RowCount() / 2)
+	t.rowEvents.Range(func(_ int, re RowEvent) bool {
+		var mm []int
+		match := true
+		for i, c := range cc {
+			hit := c.idx < len(re.Row.Fields) && rxs[i].MatchString(re.Row.Fields[c.idx])
+			if hit == c.exclude {
+				match = false
+				break
+			}
+			if !c.exclude {
+				mm = append(mm, c.idx)
+			}
+		}
+		if inverse && !match {
+			rr.Add(re)
+		}
+		if !inverse && match {
+			re.Matches = mm
+			rr.Add(re)
+		}
+
+		return true
+	})
+
+	return rr, nil
+}
+
+// ageFilter keeps rows whose age compares to the given duration per the given operator.
+// Rows with no age are filtered out.
+func (t *TableData) ageFilter(op string, d time.Duration, inverse bool) *RowEvents {
+	rr := NewRowEvents(t.RowCount() / 2)
+	idx, ok := t.header.IndexOf("AGE", true)
+	if !ok {
+		return rr
+	}
+	t.rowEvents.Range(func(_ int, re RowEvent) bool {
+		if idx >= len(re.Row.Fields) {
+			return true
+		}
+		age, ok := humanAge(re.Row.Fields[idx])
+		if !ok {
+			return true
+		}
+		if compareAge(op, age, d) != inverse {
+			rr.Add(re)
+		}
+		return true
+	})
+
+	return rr
 }
 
-// HeaderCount returns the number of header cols.
-func (t *TableData) HeaderCount() int {
-	t.mx.RLock()
-	defer t.mx.RUnlock()
-
-	return len(t.header)
+func compareAge(op string, age, d time.Duration) bool {
+	switch op {
+	case ">":
+		return age > d
+	case ">=":
+		return age >= d
+	case "<":
+		return age < d
+	case "<=":
+		return age <= d
+	default:
+		return false
+	}
 }
 
-func (t *TableData) HeadCol(n string, w bool) (header HeaderColumn, idx int) {
-	idx, ok := t.header.IndexOf(n, w)
+// labelFilter evaluates a label selector against the rows LABELS column. It returns
+// false when the selector is invalid or the table has no LABELS column.
+func (t *TableData) labelFilter(q string) (*RowEvents, bool) {
+	sel, err := labels.Parse(strings.TrimSpace(strings.TrimPrefix(q, "-l")))
+	if err != nil {
+		slog.Debug("Client side label selector parse failed", slogs.Error, err)
+		return nil, false
+	}
+	idx, ok := t.header.IndexOf("LABELS", true)
 	if !ok {
-		return HeaderColumn{}, -1
+		return nil, false
 	}
 
-	return t.header[idx], idx
-}
+	rr := NewRowEvents(t.RowCount() / 2)
+	t.rowEvents.Range(func(_ int, re RowEvent) bool {
+		if idx < len(re.Row.Fields) && sel.Matches(labels.Set(labelize(re.Row.Fields[idx]))) {
+			re.Matches = []int{idx}
+			rr.Add(re)
+		}
+		return true
+	})
 
-func (t *TableData) Filter(f FilterOpts) *TableData {
-	td := NewTableDataFromTable(t)
+	return rr, true
+}
 
-	if f.Toast {
-		td.rowEvents = t.filterToast()
-	}
-	if f.Filter == "" || internal.IsLabelSelector(f.Filter) {
-		return td
-	}
-	if f, ok := internal.IsFuzzySelector(f.Filter); ok {
-		td.rowEvents = t.fuzzyFilter(f)
-		return td
-	}
-	rr, err := t.rxFilter(f.Filter, internal.IsInverseSelector(f.Filter))
-	if err == nil {
-		td.rowEvents = rr
-	} else {
-		slog.Error("RX filter failed", slogs.Error, err)
-	}
+// kvColumns tracks the columns holding key=value pairs.
+var kvColumns = []string{"LABELS", "ANNOTATIONS"}
 
-	return td
+// kvFilter keeps rows whose labels or annotations columns carry all the given
+// key/value pairs. It returns false when the table has no such columns.
+func (t *TableData) kvFilter(kvs map[string]string, inverse bool) (*RowEvents, bool) {
+	return t.kvColsFilter(func(s string) bool {
+		return matchKV(labelize(s), kvs)
+	}, inverse)
 }
 
-func (t *TableData) rxFilter(q string, inverse bool) (*RowEvents, error) {
-	if strings.Contains(q, " ") {
-		return t.rowEvents, nil
-	}
+// hasFilter keeps rows whose labels or annotations columns carry the given key
+// regardless of its value. It returns false when the table has no such columns.
+func (t *TableData) hasFilter(key string, inverse bool) (*RowEvents, bool) {
+	return t.kvColsFilter(func(s string) bool {
+		return hasKey(s, key)
+	}, inverse)
+}
 
-	if inverse {
-		q = q[1:]
+// kvColsFilter keeps rows with a labels or annotations cell satisfying match.
+func (t *TableData) kvColsFilter(match func(string) bool, inverse bool) (*RowEvents, bool) {
+	cols := make([]int, 0, len(kvColumns))
+	for _, c := range kvColumns {
+		if idx, ok := t.header.IndexOf(c, true); ok {
+			cols = append(cols, idx)
+		}
 	}
-	rx, err := regexp.Compile(`(?i)(` + q + `)`)
-	if err != nil {
-		return nil, fmt.Errorf("invalid rx filter %q: %w", q, err)
+	if len(cols) == 0 {
+		return nil, false
 	}
 
-	vidx := t.header.FilterColIndices(t.namespace, true)
 	rr := NewRowEvents(t.RowCount() / 2)
 	t.rowEvents.Range(func(_ int, re RowEvent) bool {
-		ff := make([]string, 0, len(re.Row.Fields))
-		for idx, r := range re.Row.Fields {
-			if !vidx.Has(idx) {
-				continue
+		var mm []int
+		for _, idx := range cols {
+			if idx < len(re.Row.Fields) && match(re.Row.Fields[idx]) {
+				mm = append(mm, idx)
 			}
-			ff = append(ff, r)
 		}
-		match := rx.MatchString(strings.Join(ff, spacer))
-		if (inverse && !match) || (!inverse && match) {
+		ok := len(mm) > 0
+		if inverse && !ok {
+			rr.Add(re)
+		}
+		if !inverse && ok {
+			re.Matches = mm
 			rr.Add(re)
 		}
 
 		return true
 	})
 
-	return rr, nil
+	return rr, true
 }
 
-func (t *TableData) fuzzyFilter(q string) *RowEvents {
+// fuzzyFilter fuzzy matches rows on their ID or on their visible fields when onFields is set.
+func (t *TableData) fuzzyFilter(q string, onFields bool) *RowEvents {
 	q = strings.TrimSpace(q)
+	var vidx sets.Set[int]
+	if onFields {
+		vidx = t.colIdx.filterColIndices(t.header, t.namespace, true)
+	}
 	ss := make([]string, 0, t.RowCount()/2)
 	t.rowEvents.Range(func(_ int, re RowEvent) bool {
-		ss = append(ss, re.Row.ID)
+		if !onFields {
+			ss = append(ss, re.Row.ID)
+			return true
+		}
+		ff := make([]string, 0, len(re.Row.Fields))
+		for idx, f := range re.Row.Fields {
+			if vidx.Has(idx) {
+				ff = append(ff, f)
+			}
+		}
+		ss = append(ss, strings.Join(ff, spacer))
 		return true
 	})
 
@@ -234,6 +1308,40 @@ func (t *TableData) filterToast() *RowEvents {
 	return rr
 }
 
+// ChangedOnly returns a clone holding the rows added or updated by the last update
+// followed by tombstone rows for the ones it deleted.
+func (t *TableData) ChangedOnly() *TableData {
+	td := t.Clone()
+
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+	td.rowEvents = NewRowEvents(10)
+	t.rowEvents.Range(func(_ int, re RowEvent) bool {
+		if re.Kind != EventUnchanged {
+			td.rowEvents.Add(re.Clone())
+		}
+		return true
+	})
+	for _, re := range t.tombstones {
+		td.rowEvents.Add(re.Clone())
+	}
+
+	return td
+}
+
+// filterChanged keeps rows that were added or updated during the last update.
+func (t *TableData) filterChanged() *RowEvents {
+	rr := NewRowEvents(10)
+	t.rowEvents.Range(func(_ int, re RowEvent) bool {
+		if re.Kind != EventUnchanged {
+			rr.Add(re)
+		}
+		return true
+	})
+
+	return rr
+}
+
 func (t *TableData) GetNamespace() string {
 	t.mx.RLock()
 	defer t.mx.RUnlock()
@@ -249,33 +1357,214 @@ func (t *TableData) Reset(ns string) {
 	t.Clear()
 }
 
-func (t *TableData) Render(_ context.Context, r Renderer, oo []runtime.Object) error {
+// Render hydrates the given resources into table rows.
+// When the resources are unchanged since the last render, hydration is skipped and
+// only the volatile columns ie metrics and ages are refreshed in place. If the
+// context is canceled while rendering, the table is left untouched.
+func (t *TableData) Render(ctx context.Context, r Renderer, oo []runtime.Object) error {
+	_, err := t.RenderDelta(ctx, r, oo)
+
+	return err
+}
+
+// RenderDelta renders the given resources and returns the row changes.
+func (t *TableData) RenderDelta(ctx context.Context, r Renderer, oo []runtime.Object) (RowChanges, error) {
+	if t.gvr == nil {
+		return RowChanges{}, errNilGVR
+	}
+	if !r.IsGeneric() && t.isUnchanged(r.Header(t.GetNamespace()), oo) {
+		if changes, ok := t.refresh(r, oo); ok {
+			return changes, nil
+		}
+	}
+
 	var rows Rows
 	if len(oo) > 0 {
 		if r.IsGeneric() {
 			table, ok := oo[0].(*metav1.Table)
 			if !ok {
-				return fmt.Errorf("expecting a meta table but got %T", oo[0])
+				return RowChanges{}, fmt.Errorf("expecting a meta table but got %T", oo[0])
 			}
 			rows = make(Rows, len(table.Rows))
-			if err := GenericHydrate(t.namespace, table, rows, r); err != nil {
-				return err
+			if err := GenericHydrate(ctx, t.namespace, table, rows, r); err != nil {
+				return RowChanges{}, err
 			}
 		} else {
 			rows = make(Rows, len(oo))
-			if err := Hydrate(t.namespace, oo, rows, r); err != nil {
-				return err
+			if err := Hydrate(ctx, t.namespace, oo, rows, r); err != nil {
+				return RowChanges{}, err
 			}
 		}
 	}
 
-	t.Update(rows)
+	if err := ctx.Err(); err != nil {
+		return RowChanges{}, err
+	}
+	changes := t.update(rows)
 	t.SetHeader(t.namespace, r.Header(t.namespace))
 	if t.HeaderCount() == 0 {
-		return fmt.Errorf("no data found for resource %s", t.gvr)
+		return changes, fmt.Errorf("no data found for resource %s", t.gvr)
+	}
+	t.trackVersions(r, oo, rows)
+	if slog.Default().Enabled(ctx, slog.LevelDebug) {
+		if err := t.Validate(); err != nil {
+			slog.Warn("Malformed table rows", slogs.GVR, t.gvr, slogs.Error, err)
+		}
 	}
 
-	return nil
+	return changes, nil
+}
+
+// Validate checks each row fields count matches the header and row IDs are unique.
+// It reports all offending rows so misbehaving renderers can be tracked down.
+func (t *TableData) Validate() error {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	var errs []error
+	seen := make(map[string]struct{}, t.rowEvents.Len())
+	t.rowEvents.Range(func(_ int, re RowEvent) bool {
+		if n := len(re.Row.Fields); n != len(t.header) {
+			errs = append(errs, fmt.Errorf("row %q has %d fields, expected %d", re.Row.ID, n, len(t.header)))
+		}
+		if _, ok := seen[re.Row.ID]; ok {
+			errs = append(errs, fmt.Errorf("duplicate row id %q", re.Row.ID))
+		}
+		seen[re.Row.ID] = struct{}{}
+		return true
+	})
+
+	return errors.Join(errs...)
+}
+
+// isUnchanged checks if the resources and header match the last render.
+func (t *TableData) isUnchanged(h Header, oo []runtime.Object) bool {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	if len(oo) == 0 || len(t.versions) != len(oo) || t.rowEvents.Len() != len(oo) || t.header.Diff(t.withComputed(h)) {
+		return false
+	}
+	for _, o := range oo {
+		id, rv, ok := resourceVersion(o)
+		if !ok || rv == "" {
+			return false
+		}
+		if v, ok := t.versions[id]; !ok || v != rv {
+			return false
+		}
+	}
+
+	return true
+}
+
+// refresh updates unchanged resources without hydrating them. Only the volatile
+// columns are recomputed, the age from the resources creation timestamp and any
+// other columns from renderers implementing VolatileRenderer. It returns false when
+// volatile columns can not be refreshed and require a full render.
+func (t *TableData) refresh(r Renderer, oo []runtime.Object) (RowChanges, bool) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	vr, _ := r.(VolatileRenderer)
+	age := -1
+	for _, c := range t.header.volatileIndices() {
+		switch {
+		case t.header[c].Name == ageCol:
+			age = c
+		case vr == nil:
+			return RowChanges{}, false
+		}
+	}
+	var (
+		changes RowChanges
+		base    = len(t.header) - len(t.computed)
+	)
+	for _, o := range oo {
+		m, err := meta.Accessor(o)
+		if err != nil {
+			return RowChanges{}, false
+		}
+		index, ok := t.rowEvents.FindIndex(client.FQN(m.GetNamespace(), m.GetName()))
+		if !ok {
+			return RowChanges{}, false
+		}
+		ev, ok := t.rowEvents.At(index)
+		if !ok {
+			return RowChanges{}, false
+		}
+		var vv map[string]string
+		if vr != nil {
+			if vv, err = vr.RenderVolatile(o); err != nil {
+				return RowChanges{}, false
+			}
+		}
+		if _, ok := vv[ageCol]; !ok && age >= 0 {
+			ts := m.GetCreationTimestamp()
+			if ts.IsZero() {
+				return RowChanges{}, false
+			}
+			if vv == nil {
+				vv = make(map[string]string, 1)
+			}
+			vv[ageCol] = duration.HumanDuration(time.Since(ts.Time))
+		}
+		var ff Fields
+		for i := range min(base, len(ev.Row.Fields)) {
+			v, ok := vv[t.header[i].Name]
+			if !ok || v == ev.Row.Fields[i] {
+				continue
+			}
+			if ff == nil {
+				ff = slices.Clone(ev.Row.Fields)
+			}
+			ff[i] = v
+		}
+		if ff == nil {
+			ev.Kind, ev.Deltas = EventUnchanged, DeltaRow{}
+			t.rowEvents.Set(index, ev)
+			continue
+		}
+		row := t.compute(Row{ID: ev.Row.ID, Fields: ff}, base)
+		if delta := NewDeltaRow(ev.Row, row, t.header); !delta.IsBlank() {
+			t.rowEvents.Set(index, t.decorated(NewRowEventWithDeltas(row, delta)))
+			changes.Updated = append(changes.Updated, row.ID)
+			continue
+		}
+		ev.Kind, ev.Deltas, ev.Row = EventUnchanged, DeltaRow{}, row
+		t.rowEvents.Set(index, t.decorated(ev))
+	}
+
+	return changes, true
+}
+
+// trackVersions records the resource version of each hydrated row.
+func (t *TableData) trackVersions(r Renderer, oo []runtime.Object, rows Rows) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.versions = nil
+	if r.IsGeneric() || len(oo) != len(rows) {
+		return
+	}
+	vv := make(map[string]string, len(oo))
+	for i, o := range oo {
+		id, rv, ok := resourceVersion(o)
+		if !ok || id != rows[i].ID {
+			return
+		}
+		vv[id] = rv
+	}
+	t.versions = vv
+}
+
+func resourceVersion(o runtime.Object) (id, rv string, ok bool) {
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return "", "", false
+	}
+
+	return client.FQN(m.GetNamespace(), m.GetName()), m.GetResourceVersion(), true
 }
 
 // Empty checks if there are no entries.
@@ -381,8 +1670,11 @@ func (t *TableData) Clear() {
 	t.mx.Lock()
 	defer t.mx.Unlock()
 
-	t.header = t.header.Clear()
+	t.header, t.colIdx = t.header.Clear(), new(colIndexCache)
 	t.rowEvents.Clear()
+	t.versions = nil
+	clear(t.deletedAt)
+	t.marks.clear()
 }
 
 // Clone returns a copy of the table.
@@ -395,6 +1687,37 @@ func (t *TableData) Clone() *TableData {
 		rowEvents: t.rowEvents.Clone(),
 		namespace: t.namespace,
 		gvr:       t.gvr,
+		computed:  slices.Clone(t.computed),
+		decorate:  t.decorate,
+		aliases:   maps.Clone(t.aliases),
+		rx:        t.rx,
+		groupBy:   t.groupBy,
+		source:    t.source,
+		marks:     t.marks,
+		colIdx:    t.colIdx,
+	}
+}
+
+// Snapshot returns a read-only copy of the table at this point in time.
+// The header is cloned but rows are shared with the table rather than deep copied.
+// This is safe since updates replace rows wholesale and never mutate them in place.
+func (t *TableData) Snapshot() *TableData {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	return &TableData{
+		header:    t.header.Clone(),
+		rowEvents: t.rowEvents.ShallowClone(),
+		namespace: t.namespace,
+		gvr:       t.gvr,
+		computed:  slices.Clone(t.computed),
+		decorate:  t.decorate,
+		aliases:   maps.Clone(t.aliases),
+		rx:        t.rx,
+		groupBy:   t.groupBy,
+		source:    t.source,
+		marks:     t.marks,
+		colIdx:    t.colIdx,
 	}
 }
 
@@ -418,19 +1741,38 @@ func (t *TableData) SetHeader(ns string, h Header) {
 	t.mx.Lock()
 	defer t.mx.Unlock()
 
+	h = t.withComputed(h)
+	if t.header.Diff(h) {
+		t.colIdx = new(colIndexCache)
+	}
 	t.namespace, t.header = ns, h
+	if t.groupBy != "" {
+		t.regroup()
+	}
 }
 
 // Update computes row deltas and update the table data.
-func (t *TableData) Update(rows Rows) {
-	empty := t.Empty()
+// It returns the number of added, changed and deleted rows.
+func (t *TableData) Update(rows Rows) UpdateCounts {
+	return t.update(rows).Counts()
+}
+
+func (t *TableData) update(rows Rows) RowChanges {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	var changes RowChanges
+	empty := t.rowEvents.Empty()
+	t.tombstones = t.tombstones[:0]
 	kk := sets.New[string]()
 	var blankDelta DeltaRow
-	t.mx.Lock()
+	equal, custom := rowEqualFor(t.gvr)
 	for _, row := range rows {
+		row = t.compute(row, len(row.Fields))
 		kk.Insert(row.ID)
 		if empty {
-			t.rowEvents.Add(NewRowEvent(EventAdd, row))
+			t.rowEvents.Add(t.decorated(NewRowEvent(EventAdd, row)))
+			changes.Added = append(changes.Added, row.ID)
 			continue
 		}
 		if index, ok := t.rowEvents.FindIndex(row.ID); ok {
@@ -438,40 +1780,120 @@ func (t *TableData) Update(rows Rows) {
 			if !ok {
 				continue
 			}
+			// Rows reappearing while retained as deleted are added back.
+			if ev.Kind == EventDelete {
+				t.rowEvents.Set(index, t.decorated(NewRowEvent(EventAdd, row)))
+				changes.Added = append(changes.Added, row.ID)
+				continue
+			}
+			// Identical rows skip the delta computation altogether.
+			if sameRow(ev.Row, row) || (custom && equal(ev.Row, row)) {
+				ev.Kind, ev.Deltas, ev.Row = EventUnchanged, blankDelta, row
+				t.rowEvents.Set(index, t.decorated(ev))
+				continue
+			}
 			delta := NewDeltaRow(ev.Row, row, t.header)
 			if delta.IsBlank() {
 				ev.Kind, ev.Deltas, ev.Row = EventUnchanged, blankDelta, row
-				t.rowEvents.Set(index, ev)
+				t.rowEvents.Set(index, t.decorated(ev))
 			} else {
-				t.rowEvents.Set(index, NewRowEventWithDeltas(row, delta))
+				t.rowEvents.Set(index, t.decorated(NewRowEventWithDeltas(row, delta)))
+				changes.Updated = append(changes.Updated, row.ID)
 			}
 			continue
 		}
-		t.rowEvents.Add(NewRowEvent(EventAdd, row))
+		t.rowEvents.Add(t.decorated(NewRowEvent(EventAdd, row)))
+		changes.Added = append(changes.Added, row.ID)
 	}
-	t.mx.Unlock()
-
 	if !empty {
-		t.Delete(kk)
+		changes.Deleted = t.deleteLocked(kk)
 	}
+
+	return changes
+}
+
+// SetDeleteRetention keeps deleted rows around, marked as deleted, for the given
+// duration before removing them. A zero duration removes deleted rows right away.
+func (t *TableData) SetDeleteRetention(d time.Duration) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.retention = d
 }
 
 // Delete removes items in cache that are no longer valid.
 func (t *TableData) Delete(newKeys sets.Set[string]) {
+	t.delete(newKeys)
+}
+
+func (t *TableData) delete(newKeys sets.Set[string]) []string {
 	t.mx.Lock()
 	defer t.mx.Unlock()
 
+	return t.deleteLocked(newKeys)
+}
+
+func (t *TableData) deleteLocked(newKeys sets.Set[string]) []string {
+	if t.retention > 0 {
+		return t.retainLocked(newKeys)
+	}
+
 	victims := sets.New[string]()
+	t.tombstones = t.tombstones[:0]
 	t.rowEvents.Range(func(_ int, e RowEvent) bool {
 		if newKeys.Has(e.Row.ID) {
 			delete(newKeys, e.Row.ID)
 		} else {
 			victims.Insert(e.Row.ID)
+			e.Kind, e.Deltas = EventDelete, nil
+			t.tombstones = append(t.tombstones, e)
 		}
 		return true
 	})
 
-	for _, id := range victims.UnsortedList() {
+	ids := victims.UnsortedList()
+	t.removeLocked(ids)
+
+	return ids
+}
+
+// retainLocked marks rows no longer present as deleted and only removes them
+// once the retention window elapsed. It returns the newly deleted row IDs.
+func (t *TableData) retainLocked(newKeys sets.Set[string]) []string {
+	if t.deletedAt == nil {
+		t.deletedAt = make(map[string]time.Time)
+	}
+	now := clock()
+	var ids, expired []string
+	for i := range t.rowEvents.Len() {
+		e, ok := t.rowEvents.At(i)
+		if !ok {
+			continue
+		}
+		if newKeys.Has(e.Row.ID) {
+			delete(t.deletedAt, e.Row.ID)
+			continue
+		}
+		at, ok := t.deletedAt[e.Row.ID]
+		if !ok {
+			t.deletedAt[e.Row.ID] = now
+			e.Kind, e.Deltas = EventDelete, nil
+			t.rowEvents.Set(i, e)
+			ids = append(ids, e.Row.ID)
+			continue
+		}
+		if now.Sub(at) >= t.retention {
+			expired = append(expired, e.Row.ID)
+			delete(t.deletedAt, e.Row.ID)
+		}
+	}
+	t.removeLocked(expired)
+
+	return ids
+}
+
+func (t *TableData) removeLocked(ids []string) {
+	for _, id := range ids {
 		if err := t.rowEvents.Delete(id); err != nil {
 			slog.Error("Table delete failed",
 				slogs.Error, err,
@@ -479,6 +1901,67 @@ func (t *TableData) Delete(newKeys sets.Set[string]) {
 			)
 		}
 	}
+	t.marks.unmark(ids...)
+}
+
+// Mark marks the given row for bulk operations. Unknown rows are ignored.
+// Marks survive updates and filters and are dropped once the row is deleted.
+func (t *TableData) Mark(id string) {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	if _, ok := t.rowEvents.FindIndex(id); ok {
+		t.marks.mark(id)
+	}
+}
+
+// Unmark clears the given row mark.
+func (t *TableData) Unmark(id string) {
+	t.marks.unmark(id)
+}
+
+// IsMarked checks if the given row is marked.
+func (t *TableData) IsMarked(id string) bool {
+	return t.marks.has(id)
+}
+
+// MarkedIDs returns the sorted marked row IDs.
+func (t *TableData) MarkedIDs() []string {
+	return t.marks.list()
+}
+
+// Fingerprint returns a hash of the table header names and row ids and fields.
+// When skipVolatile is set, metrics and time columns are left out so that
+// tables only differing by those columns share a fingerprint.
+// The fingerprint is stable across runs for identical content.
+func (t *TableData) Fingerprint(skipVolatile bool) uint64 {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	var skip sets.Set[int]
+	if skipVolatile {
+		skip = sets.New(t.header.volatileIndices()...)
+	}
+	h := fnv.New64a()
+	write := func(s string, sep byte) {
+		_, _ = h.Write([]byte(s))
+		_, _ = h.Write([]byte{sep})
+	}
+	for _, c := range t.header {
+		write(c.Name, fieldSep)
+	}
+	t.rowEvents.Range(func(_ int, re RowEvent) bool {
+		write(re.Row.ID, fieldSep)
+		for i, f := range re.Row.Fields {
+			if !skip.Has(i) {
+				write(f, fieldSep)
+			}
+		}
+		write("", rowSep)
+		return true
+	})
+
+	return h.Sum64()
 }
 
 // Diff checks if two tables are equal.
@@ -492,3 +1975,61 @@ func (t *TableData) Diff(t2 *TableData) bool {
 	}
 	return t.rowEvents.Diff(t2.rowEvents, idx)
 }
+
+// DiffRows returns the rows added, removed and changed since the given previous
+// snapshot, matching rows by ID. Added and changed rows follow this table order
+// and removed rows follow the previous table order. Changes to time columns are
+// ignored. Changed rows carry the previous values as deltas.
+func (t *TableData) DiffRows(prev *TableData) (added, removed, changed []RowEvent) {
+	// Snapshot prev before locking t so the two table locks are never held at once.
+	if prev != nil {
+		prev = prev.Snapshot()
+	}
+
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	if prev == nil {
+		t.rowEvents.Range(func(_ int, re RowEvent) bool {
+			added = append(added, NewRowEvent(EventAdd, re.Row))
+			return true
+		})
+		return added, nil, nil
+	}
+
+	t.rowEvents.Range(func(_ int, re RowEvent) bool {
+		old, ok := prev.rowEvents.Get(re.Row.ID)
+		switch {
+		case !ok:
+			added = append(added, NewRowEvent(EventAdd, re.Row))
+		case rowChanged(old.Row, re.Row, t.header):
+			changed = append(changed, NewRowEventWithDeltas(re.Row, NewDeltaRow(old.Row, re.Row, t.header)))
+		}
+		return true
+	})
+	prev.rowEvents.Range(func(_ int, re RowEvent) bool {
+		if _, ok := t.rowEvents.FindIndex(re.Row.ID); !ok {
+			removed = append(removed, NewRowEvent(EventDelete, re.Row))
+		}
+		return true
+	})
+
+	return added, removed, changed
+}
+
+// rowChanged checks if any non time column differs between the two rows.
+func rowChanged(o, n Row, h Header) bool {
+	if sameRow(o, n) {
+		return false
+	}
+	if len(o.Fields) != len(n.Fields) {
+		return true
+	}
+	for i := range n.Fields {
+		if o.Fields[i] != n.Fields[i] && !h.IsTimeCol(i) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -4,12 +4,24 @@
 package model1
 
 import (
+	"context"
+	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/tview"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
@@ -369,3 +381,2612 @@ func TestTableDataDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestTableDataDeleteRetention(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock = func() time.Time { return now }
+	t.Cleanup(func() { clock = time.Now })
+
+	td := NewTableData(client.NewGVR("v1/pods"))
+	td.SetHeader("", Header{HeaderColumn{Name: "NAME"}})
+	td.SetDeleteRetention(10 * time.Second)
+	td.Update(Rows{
+		{ID: "fred", Fields: Fields{"fred"}},
+		{ID: "blee", Fields: Fields{"blee"}},
+		{ID: "zorg", Fields: Fields{"zorg"}},
+	})
+
+	c := td.Update(Rows{{ID: "fred", Fields: Fields{"fred"}}})
+	assert.Equal(t, 2, c.Deleted)
+	assert.Equal(t, []string{"fred", "blee", "zorg"}, rowIDs(td))
+	re, ok := td.GetRowEvents().Get("blee")
+	require.True(t, ok)
+	assert.Equal(t, EventDelete, re.Kind)
+
+	now = now.Add(5 * time.Second)
+	c = td.Update(Rows{
+		{ID: "fred", Fields: Fields{"fred"}},
+		{ID: "zorg", Fields: Fields{"zorg"}},
+	})
+	assert.Equal(t, 0, c.Deleted)
+	assert.Equal(t, 1, c.Added)
+	assert.Equal(t, []string{"fred", "blee", "zorg"}, rowIDs(td))
+	re, ok = td.GetRowEvents().Get("zorg")
+	require.True(t, ok)
+	assert.Equal(t, EventAdd, re.Kind)
+
+	now = now.Add(5 * time.Second)
+	td.Update(Rows{
+		{ID: "fred", Fields: Fields{"fred"}},
+		{ID: "zorg", Fields: Fields{"zorg"}},
+	})
+	assert.Equal(t, []string{"fred", "zorg"}, rowIDs(td))
+}
+
+func TestTableDataMarks(t *testing.T) {
+	td := NewTableData(client.NewGVR("v1/pods"))
+	td.SetHeader("", Header{HeaderColumn{Name: "NAME"}})
+	td.Update(Rows{
+		{ID: "fred", Fields: Fields{"fred"}},
+		{ID: "blee", Fields: Fields{"blee"}},
+		{ID: "zorg", Fields: Fields{"zorg"}},
+	})
+
+	td.Mark("zorg")
+	td.Mark("fred")
+	td.Mark("duh")
+	assert.True(t, td.IsMarked("fred"))
+	assert.False(t, td.IsMarked("blee"))
+	assert.False(t, td.IsMarked("duh"))
+	assert.Equal(t, []string{"fred", "zorg"}, td.MarkedIDs())
+
+	f := td.Filter(FilterOpts{Filter: "blee"})
+	assert.Equal(t, []string{"fred", "zorg"}, f.MarkedIDs())
+	f.Mark("blee")
+	assert.True(t, td.IsMarked("blee"))
+	td.Unmark("blee")
+	assert.False(t, f.IsMarked("blee"))
+
+	td.Update(Rows{
+		{ID: "fred", Fields: Fields{"fred"}},
+		{ID: "blee", Fields: Fields{"blee"}},
+	})
+	assert.Equal(t, []string{"fred"}, td.MarkedIDs())
+	assert.Equal(t, []string{"fred"}, td.Clone().MarkedIDs())
+
+	td.Clear()
+	assert.Empty(t, td.MarkedIDs())
+}
+
+func TestTableDataRenderUnchanged(t *testing.T) {
+	uu := map[string]struct {
+		h        Header
+		rv1, rv2 string
+		mx       string
+		renders  int
+		e        Fields
+		kind     ResEvent
+	}{
+		"unchanged": {
+			h:       Header{HeaderColumn{Name: "NAME"}, HeaderColumn{Name: "STATUS"}},
+			rv1:     "1",
+			rv2:     "1",
+			renders: 1,
+			e:       Fields{"fred", "ok"},
+			kind:    EventUnchanged,
+		},
+		"new-version": {
+			h:       Header{HeaderColumn{Name: "NAME"}, HeaderColumn{Name: "STATUS"}},
+			rv1:     "1",
+			rv2:     "2",
+			renders: 2,
+			e:       Fields{"fred", "boom"},
+			kind:    EventUpdate,
+		},
+		"metrics": {
+			h: Header{
+				HeaderColumn{Name: "NAME"},
+				HeaderColumn{Name: "STATUS"},
+				HeaderColumn{Name: "CPU", Attrs: Attrs{MX: true}},
+			},
+			rv1:     "1",
+			rv2:     "1",
+			mx:      "20",
+			renders: 2,
+			e:       Fields{"fred", "boom", "20"},
+			kind:    EventUpdate,
+		},
+		"age": {
+			h: Header{
+				HeaderColumn{Name: "NAME"},
+				HeaderColumn{Name: "STATUS"},
+				HeaderColumn{Name: "AGE", Attrs: Attrs{Time: true}},
+			},
+			rv1:     "1",
+			rv2:     "1",
+			mx:      "20",
+			renders: 1,
+			e:       Fields{"fred", "ok", "5h"},
+			kind:    EventUnchanged,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := &testRenderer{header: u.h, status: "ok", mx: "10"}
+			td := NewTableData(client.NewGVR("test"))
+			o := testObj("fred", u.rv1)
+			o.SetCreationTimestamp(metav1.NewTime(time.Now().Add(-5 * time.Hour)))
+			require.NoError(t, td.Render(context.Background(), r, []runtime.Object{o}))
+
+			r.status, r.mx = "boom", u.mx
+			o = testObj("fred", u.rv2)
+			o.SetCreationTimestamp(metav1.NewTime(time.Now().Add(-5 * time.Hour)))
+			require.NoError(t, td.Render(context.Background(), r, []runtime.Object{o}))
+			assert.Equal(t, int32(u.renders), r.count.Load())
+
+			re, ok := td.RowAt(0)
+			require.True(t, ok)
+			assert.Equal(t, u.e, re.Row.Fields)
+			assert.Equal(t, u.kind, re.Kind)
+		})
+	}
+}
+
+func TestTableDataRenderDeltaMetrics(t *testing.T) {
+	r := testMXRenderer{
+		testRenderer: testRenderer{
+			header: Header{
+				HeaderColumn{Name: "NAME"},
+				HeaderColumn{Name: "STATUS"},
+				HeaderColumn{Name: "CPU", Attrs: Attrs{MX: true}},
+				HeaderColumn{Name: "MEM", Attrs: Attrs{MX: true}},
+				HeaderColumn{Name: "AGE", Attrs: Attrs{Time: true}},
+			},
+			status: "ok",
+		},
+		cpu: "10",
+		mem: "1",
+	}
+	td := NewTableData(client.NewGVR("v1/pods"))
+	o := testObj("fred", "1")
+	o.SetCreationTimestamp(metav1.NewTime(time.Now().Add(-5 * time.Hour)))
+	_, err := td.RenderDelta(context.Background(), &r, []runtime.Object{o})
+	require.NoError(t, err)
+
+	r.status, r.cpu = "boom", "20"
+	changes, err := td.RenderDelta(context.Background(), &r, []runtime.Object{o})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), r.count.Load())
+	assert.Equal(t, []string{"fred"}, changes.Updated)
+	re, ok := td.RowAt(0)
+	require.True(t, ok)
+	assert.Equal(t, Fields{"fred", "ok", "20", "1", "5h"}, re.Row.Fields)
+	assert.Equal(t, EventUpdate, re.Kind)
+	assert.Equal(t, "10", re.Deltas[2])
+
+	changes, err = td.RenderDelta(context.Background(), &r, []runtime.Object{o})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), r.count.Load())
+	assert.Empty(t, changes.Updated)
+	re, ok = td.RowAt(0)
+	require.True(t, ok)
+	assert.Equal(t, EventUnchanged, re.Kind)
+}
+
+func TestTableDataRenderDelta(t *testing.T) {
+	r := &testRenderer{
+		header: Header{HeaderColumn{Name: "NAME"}, HeaderColumn{Name: "STATUS"}},
+		status: "ok",
+	}
+	td := NewTableData(client.NewGVR("test"))
+
+	cc, err := td.RenderDelta(context.Background(), r, []runtime.Object{testObj("fred", "1"), testObj("blee", "1")})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"fred", "blee"}, cc.Added)
+	assert.Empty(t, cc.Updated)
+	assert.Empty(t, cc.Deleted)
+
+	r.status = "boom"
+	cc, err = td.RenderDelta(context.Background(), r, []runtime.Object{testObj("fred", "2"), testObj("zorg", "1")})
+	require.NoError(t, err)
+	assert.Equal(t, RowChanges{Added: []string{"zorg"}, Updated: []string{"fred"}, Deleted: []string{"blee"}}, cc)
+
+	cc, err = td.RenderDelta(context.Background(), r, []runtime.Object{testObj("fred", "2"), testObj("zorg", "1")})
+	require.NoError(t, err)
+	assert.True(t, cc.IsEmpty())
+}
+
+func TestTableDataFilter(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "STATUS"},
+			HeaderColumn{Name: "VALID", Attrs: Attrs{Wide: true}},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred", "Running", ""}}},
+			RowEvent{Row: Row{ID: "foo-1", Fields: Fields{"foo-1", "Error", "bad"}}},
+			RowEvent{Row: Row{ID: "blee", Fields: Fields{"blee", "Error", "bad"}}},
+			RowEvent{Row: Row{ID: "foo-2", Fields: Fields{"foo-2", "Running", ""}}},
+		),
+	)
+
+	uu := map[string]struct {
+		opts FilterOpts
+		e    []string
+	}{
+		"none": {
+			e: []string{"fred", "foo-1", "blee", "foo-2"},
+		},
+		"rx": {
+			opts: FilterOpts{Filter: "foo"},
+			e:    []string{"foo-1", "foo-2"},
+		},
+		"rx-inverse-query": {
+			opts: FilterOpts{Filter: "!foo"},
+			e:    []string{"fred", "blee"},
+		},
+		"rx-invert": {
+			opts: FilterOpts{Filter: "foo", Invert: true},
+			e:    []string{"fred", "blee"},
+		},
+		"toast": {
+			opts: FilterOpts{Toast: true},
+			e:    []string{"foo-1", "blee"},
+		},
+		"toast-rx": {
+			opts: FilterOpts{Toast: true, Filter: "foo"},
+			e:    []string{"foo-1"},
+		},
+		"toast-invert": {
+			opts: FilterOpts{Toast: true, Filter: "foo", Invert: true},
+			e:    []string{"blee"},
+		},
+		"toast-fuzzy": {
+			opts: FilterOpts{Toast: true, Filter: "-f blee"},
+			e:    []string{"blee"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, rowIDs(td.Filter(u.opts)))
+		})
+	}
+}
+
+func TestTableDataFilterSuccessive(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "STATUS"},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred", "Running"}}},
+			RowEvent{Row: Row{ID: "foo-1", Fields: Fields{"foo-1", "Error"}}},
+			RowEvent{Row: Row{ID: "foo-10", Fields: Fields{"foo-10", "Running"}}},
+			RowEvent{Row: Row{ID: "foo-2", Fields: Fields{"foo-2", "Running"}}},
+		),
+	)
+
+	uu := map[string]struct {
+		ff []string
+		e  []string
+	}{
+		"narrow": {
+			ff: []string{"foo", "foo-1"},
+			e:  []string{"foo-1", "foo-10"},
+		},
+		"broaden": {
+			ff: []string{"foo-10", "foo"},
+			e:  []string{"foo-1", "foo-10", "foo-2"},
+		},
+		"switch": {
+			ff: []string{"foo", "fred"},
+			e:  []string{"fred"},
+		},
+		"clear": {
+			ff: []string{"foo-2", ""},
+			e:  []string{"fred", "foo-1", "foo-10", "foo-2"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := td
+			for _, q := range u.ff {
+				f = f.Filter(FilterOpts{Filter: q})
+				assert.Same(t, td, f.Unfiltered())
+			}
+			assert.Equal(t, u.e, rowIDs(f))
+		})
+	}
+}
+
+func TestTableDataFilterColumns(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "STATUS"},
+			HeaderColumn{Name: "NODE", Attrs: Attrs{Wide: true}},
+			HeaderColumn{Name: "LABELS", Attrs: Attrs{Wide: true}},
+			HeaderColumn{Name: "LAST SEEN", Attrs: Attrs{Time: true}},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred", "Running", "ip-10-0-1", "app=fred", "5m"}}},
+			RowEvent{Row: Row{ID: "blee", Fields: Fields{"blee", "Running", "ip-10-1-1", "app=blee,tier=fe", "1h"}}},
+			RowEvent{Row: Row{ID: "zorg", Fields: Fields{"zorg", "Error", "ip-10-0-2", "", "5m"}}},
+			RowEvent{Row: Row{ID: "duh", Fields: Fields{"duh", "Running", "ip-10-2-1", "tier=fe", "2d"}}},
+		),
+	)
+
+	uu := map[string]struct {
+		q       string
+		inverse bool
+		e       []string
+	}{
+		"include": {
+			q: "status:Running",
+			e: []string{"fred", "blee", "duh"},
+		},
+		"exclude": {
+			q: "-node:ip-10-0",
+			e: []string{"blee", "duh"},
+		},
+		"mixed": {
+			q: "status:Running -node:ip-10-0",
+			e: []string{"blee", "duh"},
+		},
+		"multi-exclude": {
+			q: "status:running -node:ip-10-0 -name:duh",
+			e: []string{"blee"},
+		},
+		"inverse": {
+			q: "!status:Running -node:ip-10-0",
+			e: []string{"fred", "zorg"},
+		},
+		"invert-opt": {
+			q:       "status:Running -node:ip-10-0",
+			inverse: true,
+			e:       []string{"fred", "zorg"},
+		},
+		"exclude-l-col": {
+			q: "-labels:tier",
+			e: []string{"fred", "zorg"},
+		},
+		"exclude-spaced-col": {
+			q: "-last seen:5m",
+			e: []string{"blee", "duh"},
+		},
+		"mixed-spaced-col": {
+			q: "status:Running -last seen:5m -labels:app",
+			e: []string{"duh"},
+		},
+		"label-selector": {
+			q: "-l app=fred",
+			e: []string{"fred"},
+		},
+		"unknown-col": {
+			q: "zorg:blee",
+			e: []string{},
+		},
+		"bad-rx": {
+			q: "status:(",
+			e: []string{"fred", "blee", "zorg", "duh"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, rowIDs(td.Filter(FilterOpts{Filter: u.q, Invert: u.inverse})))
+		})
+	}
+}
+
+func TestTableDataFilterColIndicesCache(t *testing.T) {
+	td := NewTableData(client.NewGVR("test"))
+	td.SetHeader("fred", Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "STATUS"},
+	})
+	td.Update(Rows{
+		{ID: "fred", Fields: Fields{"fred", "Running"}},
+		{ID: "blee", Fields: Fields{"blee", "Error"}},
+	})
+	assert.Equal(t, []string{"blee"}, rowIDs(td.Filter(FilterOpts{Filter: "error"})))
+
+	c := td.colIdx
+	td.SetHeader("fred", Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "STATUS"},
+	})
+	assert.Same(t, c, td.colIdx)
+	assert.Same(t, c, td.Clone().colIdx)
+
+	td.SetHeader("fred", Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "STATUS", Attrs: Attrs{Hide: true}},
+	})
+	assert.NotSame(t, c, td.colIdx)
+	assert.Empty(t, rowIDs(td.Filter(FilterOpts{Filter: "error"})))
+}
+
+func TestTableDataFilterChanged(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "VALID", Attrs: Attrs{Wide: true}},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Kind: EventUnchanged, Row: Row{ID: "fred", Fields: Fields{"fred", ""}}},
+			RowEvent{Kind: EventAdd, Row: Row{ID: "foo-1", Fields: Fields{"foo-1", "bad"}}},
+			RowEvent{Kind: EventUpdate, Row: Row{ID: "blee", Fields: Fields{"blee", ""}}, Deltas: DeltaRow{"", "bad"}},
+			RowEvent{Kind: EventUnchanged, Row: Row{ID: "foo-2", Fields: Fields{"foo-2", "bad"}}},
+			RowEvent{Kind: EventUpdate, Row: Row{ID: "foo-3", Fields: Fields{"foo-3", ""}}, Deltas: DeltaRow{"", "bad"}},
+		),
+	)
+
+	uu := map[string]struct {
+		opts FilterOpts
+		e    []string
+	}{
+		"changed": {
+			opts: FilterOpts{ChangedOnly: true},
+			e:    []string{"foo-1", "blee", "foo-3"},
+		},
+		"changed-toast": {
+			opts: FilterOpts{ChangedOnly: true, Toast: true},
+			e:    []string{"foo-1"},
+		},
+		"changed-rx": {
+			opts: FilterOpts{ChangedOnly: true, Filter: "foo"},
+			e:    []string{"foo-1", "foo-3"},
+		},
+		"changed-invert": {
+			opts: FilterOpts{ChangedOnly: true, Filter: "foo", Invert: true},
+			e:    []string{"blee"},
+		},
+		"changed-fuzzy": {
+			opts: FilterOpts{ChangedOnly: true, Filter: "-f blee"},
+			e:    []string{"blee"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, rowIDs(td.Filter(u.opts)))
+		})
+	}
+}
+
+func TestTableDataFilterLimit(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "VALID", Attrs: Attrs{Wide: true}},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "fred-1", Fields: Fields{"fred-1", ""}}},
+			RowEvent{Row: Row{ID: "blee", Fields: Fields{"blee", "bad"}}},
+			RowEvent{Row: Row{ID: "fred-2", Fields: Fields{"fred-2", "bad"}}},
+			RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred", ""}}},
+		),
+	)
+
+	uu := map[string]struct {
+		opts      FilterOpts
+		e         []string
+		total     int
+		truncated bool
+	}{
+		"unlimited": {
+			opts: FilterOpts{Filter: "fred"},
+			e:    []string{"fred-1", "fred-2", "fred"},
+		},
+		"no-filter": {
+			opts:      FilterOpts{Limit: 2},
+			e:         []string{"fred-1", "blee"},
+			total:     4,
+			truncated: true,
+		},
+		"rx": {
+			opts:      FilterOpts{Filter: "fred", Limit: 2},
+			e:         []string{"fred-1", "fred-2"},
+			total:     3,
+			truncated: true,
+		},
+		"fuzzy": {
+			opts:      FilterOpts{Filter: "-f fred", Limit: 1},
+			e:         []string{"fred"},
+			total:     3,
+			truncated: true,
+		},
+		"toast": {
+			opts: FilterOpts{Toast: true, Limit: 2},
+			e:    []string{"blee", "fred-2"},
+		},
+		"under-limit": {
+			opts: FilterOpts{Filter: "blee", Limit: 2},
+			e:    []string{"blee"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ftd := td.Filter(u.opts)
+			assert.Equal(t, u.e, rowIDs(ftd))
+			total, ok := ftd.Truncated()
+			assert.Equal(t, u.truncated, ok)
+			assert.Equal(t, u.total, total)
+		})
+	}
+}
+
+func TestTableDataNamedFilter(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("v1/pods"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "VALID", Attrs: Attrs{Wide: true}},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred", ""}}},
+			RowEvent{Row: Row{ID: "foo", Fields: Fields{"foo", "bad"}}},
+			RowEvent{Row: Row{ID: "blee", Fields: Fields{"blee", "bad"}}},
+		),
+	)
+	cf := config.NewCustomFilters()
+	opts := FilterOpts{Filter: "foo", Invert: true, Toast: true, FuzzyOnFields: true, ClientLabels: true}
+
+	require.NoError(t, td.SaveFilter(cf, "busted", opts))
+	assert.Equal(t, []string{"busted"}, td.NamedFilters(cf))
+	f, ok := cf.Get("v1/pods", "busted")
+	require.True(t, ok)
+	assert.Equal(t, opts, NewFilterOpts(f))
+
+	ftd, err := td.FilterNamed(cf, "busted")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"blee"}, rowIDs(ftd))
+
+	_, err = td.FilterNamed(cf, "zorg")
+	assert.Error(t, err)
+}
+
+// Helpers...
+
+func rowIDs(td *TableData) []string {
+	ids := make([]string, 0, td.RowCount())
+	td.RowsRange(func(_ int, re RowEvent) bool {
+		ids = append(ids, re.Row.ID)
+		return true
+	})
+
+	return ids
+}
+
+func TestTableDataRenderCanceled(t *testing.T) {
+	r := &testRenderer{
+		header: Header{HeaderColumn{Name: "NAME"}, HeaderColumn{Name: "STATUS"}},
+		status: "ok",
+	}
+	oo := make([]runtime.Object, 0, 50)
+	for i := range 50 {
+		oo = append(oo, testObj(fmt.Sprintf("fred-%d", i), "1"))
+	}
+	td := NewTableData(client.NewGVR("test"))
+	require.NoError(t, td.Render(context.Background(), r, oo))
+	prev := tableRows(td)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r.status, r.cancel = "boom", cancel
+	r.header = append(r.header, HeaderColumn{Name: "AGE", Attrs: Attrs{Time: true}})
+	oo = append(oo[:10], testObj("blee", "1"))
+	require.ErrorIs(t, td.Render(ctx, r, oo), context.Canceled)
+
+	assert.Equal(t, prev, tableRows(td))
+	assert.Equal(t, 2, td.HeaderCount())
+}
+
+func tableRows(td *TableData) Rows {
+	rr := make(Rows, 0, td.RowCount())
+	td.RowsRange(func(_ int, re RowEvent) bool {
+		rr = append(rr, re.Row.Clone())
+		return true
+	})
+
+	return rr
+}
+
+type testRenderer struct {
+	header     Header
+	status, mx string
+	count      atomic.Int32
+	cancel     context.CancelFunc
+}
+
+func (*testRenderer) IsGeneric() bool                    { return false }
+func (r *testRenderer) Header(string) Header             { return r.header }
+func (*testRenderer) ColorerFunc() ColorerFunc           { return nil }
+func (*testRenderer) SetViewSetting(*config.ViewSetting) {}
+func (*testRenderer) Healthy(context.Context, any) error { return nil }
+func (r *testRenderer) Render(o any, _ string, row *Row) error {
+	r.count.Add(1)
+	if r.cancel != nil {
+		r.cancel()
+	}
+	u := o.(*unstructured.Unstructured)
+	row.ID, row.Fields = u.GetName(), Fields{u.GetName(), r.status}
+	if len(r.header) > 2 {
+		row.Fields = append(row.Fields, r.mx)
+	}
+
+	return nil
+}
+
+type testMXRenderer struct {
+	testRenderer
+	cpu, mem string
+}
+
+func (r *testMXRenderer) Render(o any, _ string, row *Row) error {
+	r.count.Add(1)
+	u := o.(*unstructured.Unstructured)
+	row.ID, row.Fields = u.GetName(), Fields{u.GetName(), r.status, r.cpu, r.mem, "1m"}
+
+	return nil
+}
+
+func (r *testMXRenderer) RenderVolatile(any) (map[string]string, error) {
+	return map[string]string{"CPU": r.cpu, "MEM": r.mem}, nil
+}
+
+func testObj(n, rv string) *unstructured.Unstructured {
+	var u unstructured.Unstructured
+	u.SetName(n)
+	u.SetResourceVersion(rv)
+
+	return &u
+}
+
+func TestTableDataRowByField(t *testing.T) {
+	td := NewTableDataFull(
+		client.NewGVR("test"),
+		client.NamespaceAll,
+		Header{
+			HeaderColumn{Name: "NAMESPACE"},
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "IP", Attrs: Attrs{Wide: true}},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "ns1/fred", Fields: Fields{"ns1", "fred", "10.0.0.1"}}},
+			RowEvent{Row: Row{ID: "ns2/blee", Fields: Fields{"ns2", "blee", "10.0.0.2"}}},
+			RowEvent{Row: Row{ID: "ns3/blee", Fields: Fields{"ns3", "blee", "10.0.0.3"}}},
+		),
+	)
+
+	uu := map[string]struct {
+		col, val string
+		id       string
+		ok       bool
+	}{
+		"name": {
+			col: "NAME",
+			val: "fred",
+			id:  "ns1/fred",
+			ok:  true,
+		},
+		"first-match": {
+			col: "NAME",
+			val: "blee",
+			id:  "ns2/blee",
+			ok:  true,
+		},
+		"wide": {
+			col: "IP",
+			val: "10.0.0.3",
+			id:  "ns3/blee",
+			ok:  true,
+		},
+		"no-match": {
+			col: "NAME",
+			val: "zorg",
+		},
+		"no-col": {
+			col: "BLEE",
+			val: "fred",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			re, ok := td.RowByField(u.col, u.val)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.id, re.Row.ID)
+		})
+	}
+}
+
+func TestTableDataRxFilterMatches(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "STATUS"},
+			HeaderColumn{Name: "NODE"},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred", "Running", "n1"}}},
+			RowEvent{Row: Row{ID: "blee", Fields: Fields{"blee", "Pending", "fred-node"}}},
+			RowEvent{Row: Row{ID: "zorg", Fields: Fields{"zorg", "Running", "n2"}}},
+		),
+	)
+
+	uu := map[string]struct {
+		q       string
+		inverse bool
+		e       map[string][]int
+	}{
+		"single-col": {
+			q: "pending",
+			e: map[string][]int{"blee": {1}},
+		},
+		"multi-rows": {
+			q: "fred",
+			e: map[string][]int{"fred": {0}, "blee": {2}},
+		},
+		"multi-cols": {
+			q: "n",
+			e: map[string][]int{"fred": {1, 2}, "blee": {1, 2}, "zorg": {1, 2}},
+		},
+		"cross-boundary": {
+			q: `g\sRunning`,
+			e: map[string][]int{},
+		},
+		"inverse": {
+			q:       "fred",
+			inverse: true,
+			e:       map[string][]int{"zorg": nil},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rr, err := td.rxFilter(u.q, u.inverse)
+			require.NoError(t, err)
+			mm := make(map[string][]int, rr.Len())
+			rr.Range(func(_ int, re RowEvent) bool {
+				mm[re.Row.ID] = re.Matches
+				return true
+			})
+			assert.Equal(t, u.e, mm)
+		})
+	}
+}
+
+func TestRowEventCustomizeMatches(t *testing.T) {
+	re := RowEvent{
+		Row:     Row{ID: "fred", Fields: Fields{"a", "b", "c"}},
+		Matches: []int{0, 2},
+	}
+	c := re.Customize([]int{2, 1})
+
+	assert.Equal(t, []int{0}, c.Matches)
+	assert.True(t, c.HasMatch(0))
+	assert.False(t, c.HasMatch(1))
+}
+
+func TestTableDataFilterCrossColumn(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "STATUS"},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "a", Fields: Fields{"field1end", "field2start"}}},
+			RowEvent{Row: Row{ID: "b", Fields: Fields{"field1end.field2start", "ok"}}},
+		),
+	)
+
+	uu := map[string]struct {
+		q string
+		e []string
+	}{
+		"spanning": {
+			q: `end.field2`,
+			e: []string{"b"},
+		},
+		"spanning-inverse": {
+			q: `!1end.f`,
+			e: []string{"a"},
+		},
+		"within": {
+			q: `2start`,
+			e: []string{"a", "b"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, rowIDs(td.Filter(FilterOpts{Filter: u.q})))
+		})
+	}
+}
+
+func TestTableDataCountByColumn(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "STATUS"},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "a", Fields: Fields{"a", "Running"}}},
+			RowEvent{Row: Row{ID: "b", Fields: Fields{"b", "Pending"}}},
+			RowEvent{Row: Row{ID: "c", Fields: Fields{"c", "Running"}}},
+			RowEvent{Row: Row{ID: "d", Fields: Fields{"d", ""}}},
+		),
+	)
+
+	uu := map[string]struct {
+		col string
+		e   map[string]int
+		ok  bool
+	}{
+		"status": {
+			col: "STATUS",
+			e:   map[string]int{"Running": 2, "Pending": 1, "": 1},
+			ok:  true,
+		},
+		"missing": {
+			col: "BLEE",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			mm, ok := td.CountByColumn(u.col)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, mm)
+		})
+	}
+}
+
+func TestTableDataFilterAnchors(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "STATUS"},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "web", Fields: Fields{"web", "Running"}}},
+			RowEvent{Row: Row{ID: "web-1", Fields: Fields{"web-1", "Running"}}},
+			RowEvent{Row: Row{ID: "myweb", Fields: Fields{"myweb", "Running"}}},
+		),
+	)
+
+	uu := map[string]struct {
+		q string
+		e []string
+	}{
+		"unanchored": {
+			q: "web",
+			e: []string{"web", "web-1", "myweb"},
+		},
+		"start": {
+			q: "^web",
+			e: []string{"web", "web-1"},
+		},
+		"start-inverse": {
+			q: "!^web",
+			e: []string{"myweb"},
+		},
+		"exact": {
+			q: "-x web",
+			e: []string{"web"},
+		},
+		"exact-rx": {
+			q: `-x web-\d`,
+			e: []string{"web-1"},
+		},
+		"exact-inverse": {
+			q: "!-x web",
+			e: []string{"web-1", "myweb"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, rowIDs(td.Filter(FilterOpts{Filter: u.q})))
+		})
+	}
+}
+
+func TestTableDataUpdateCounts(t *testing.T) {
+	uu := map[string]struct {
+		re *RowEvents
+		rr Rows
+		e  UpdateCounts
+	}{
+		"empty": {
+			re: NewRowEvents(0),
+			rr: Rows{
+				Row{ID: "A", Fields: Fields{"1"}},
+				Row{ID: "B", Fields: Fields{"2"}},
+			},
+			e: UpdateCounts{Added: 2},
+		},
+		"mixed": {
+			re: NewRowEventsWithEvts(
+				RowEvent{Row: Row{ID: "A", Fields: Fields{"1"}}},
+				RowEvent{Row: Row{ID: "B", Fields: Fields{"2"}}},
+				RowEvent{Row: Row{ID: "C", Fields: Fields{"3"}}},
+				RowEvent{Row: Row{ID: "D", Fields: Fields{"4"}}},
+			),
+			rr: Rows{
+				Row{ID: "A", Fields: Fields{"10"}},
+				Row{ID: "B", Fields: Fields{"2"}},
+				Row{ID: "E", Fields: Fields{"5"}},
+				Row{ID: "F", Fields: Fields{"6"}},
+				Row{ID: "G", Fields: Fields{"7"}},
+			},
+			e: UpdateCounts{Added: 3, Changed: 1, Deleted: 2},
+		},
+		"unchanged": {
+			re: NewRowEventsWithEvts(
+				RowEvent{Row: Row{ID: "A", Fields: Fields{"1"}}},
+			),
+			rr: Rows{
+				Row{ID: "A", Fields: Fields{"1"}},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var table TableData
+			table.SetRowEvents(u.re)
+			assert.Equal(t, u.e, table.Update(u.rr))
+		})
+	}
+}
+
+func TestTableDataColumn(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "STATUS"},
+			HeaderColumn{Name: "IP", Attrs: Attrs{Wide: true}},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "b", Fields: Fields{"b", "Pending", "10.0.0.2"}}},
+			RowEvent{Row: Row{ID: "a", Fields: Fields{"a", "Running", "10.0.0.1"}}},
+		),
+	)
+
+	uu := map[string]struct {
+		col  string
+		wide bool
+		e    []string
+		ok   bool
+	}{
+		"status": {
+			col: "STATUS",
+			e:   []string{"Pending", "Running"},
+			ok:  true,
+		},
+		"wide": {
+			col:  "IP",
+			wide: true,
+			e:    []string{"10.0.0.2", "10.0.0.1"},
+			ok:   true,
+		},
+		"wide-hidden": {
+			col: "IP",
+		},
+		"missing": {
+			col: "BLEE",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			vv, ok := td.Column(u.col, u.wide)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, vv)
+		})
+	}
+}
+
+func TestTableDataAddComputedColumn(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "RESTARTS"},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "a", Fields: Fields{"a", "1"}}},
+		),
+	)
+	td.AddComputedColumn("DOUBLE", func(r Row) string {
+		return r.Fields[1] + r.Fields[1]
+	})
+
+	assert.Equal(t, []string{"NAME", "RESTARTS", "DOUBLE"}, td.ColumnNames(true))
+	re, ok := td.RowAt(0)
+	require.True(t, ok)
+	assert.Equal(t, Fields{"a", "1", "11"}, re.Row.Fields)
+
+	td.Update(Rows{
+		Row{ID: "a", Fields: Fields{"a", "2"}},
+		Row{ID: "b", Fields: Fields{"b", "3"}},
+	})
+	vv, ok := td.Column("DOUBLE", true)
+	require.True(t, ok)
+	assert.Equal(t, []string{"22", "33"}, vv)
+	re, ok = td.RowAt(0)
+	require.True(t, ok)
+	assert.Equal(t, EventUpdate, re.Kind)
+
+	td.SetHeader("", Header{HeaderColumn{Name: "NAME"}, HeaderColumn{Name: "RESTARTS"}})
+	assert.Equal(t, []string{"NAME", "RESTARTS", "DOUBLE"}, td.ColumnNames(true))
+	assert.Equal(t, []string{"b"}, rowIDs(td.Filter(FilterOpts{Filter: "33"})))
+	assert.Equal(t, []string{"NAME", "RESTARTS", "DOUBLE"}, td.Clone().ColumnNames(true))
+}
+
+func TestTableDataComputedRender(t *testing.T) {
+	r := &testRenderer{
+		header: Header{HeaderColumn{Name: "NAME"}, HeaderColumn{Name: "STATUS"}},
+		status: "ok",
+	}
+	td := NewTableData(client.NewGVR("test"))
+	td.AddComputedColumn("LOUD", func(r Row) string {
+		return strings.ToUpper(r.Fields[1])
+	})
+	require.NoError(t, td.Render(context.Background(), r, []runtime.Object{testObj("fred", "1")}))
+	re, ok := td.RowAt(0)
+	require.True(t, ok)
+	assert.Equal(t, Fields{"fred", "ok", "OK"}, re.Row.Fields)
+
+	require.NoError(t, td.Render(context.Background(), r, []runtime.Object{testObj("fred", "1")}))
+	assert.Equal(t, int32(1), r.count.Load())
+
+	r.status = "boom"
+	require.NoError(t, td.Render(context.Background(), r, []runtime.Object{testObj("fred", "2")}))
+	re, ok = td.RowAt(0)
+	require.True(t, ok)
+	assert.Equal(t, Fields{"fred", "boom", "BOOM"}, re.Row.Fields)
+}
+
+func TestTableDataReorderColumns(t *testing.T) {
+	uu := map[string]struct {
+		order []string
+		eCols []string
+		e     Fields
+		err   string
+	}{
+		"none": {
+			eCols: []string{"NAMESPACE", "NAME", "STATUS", "CPU"},
+			e:     Fields{"ns1", "fred", "Running", "10"},
+		},
+		"partial": {
+			order: []string{"CPU"},
+			eCols: []string{"CPU", "NAMESPACE", "NAME", "STATUS"},
+			e:     Fields{"10", "ns1", "fred", "Running"},
+		},
+		"full": {
+			order: []string{"STATUS", "CPU", "NAME", "NAMESPACE"},
+			eCols: []string{"STATUS", "CPU", "NAME", "NAMESPACE"},
+			e:     Fields{"Running", "10", "fred", "ns1"},
+		},
+		"missing": {
+			order: []string{"CPU", "BLEE"},
+			eCols: []string{"NAMESPACE", "NAME", "STATUS", "CPU"},
+			e:     Fields{"ns1", "fred", "Running", "10"},
+			err:   `no column named "BLEE"`,
+		},
+		"dups": {
+			order: []string{"CPU", "CPU"},
+			eCols: []string{"NAMESPACE", "NAME", "STATUS", "CPU"},
+			e:     Fields{"ns1", "fred", "Running", "10"},
+			err:   `duplicate column "CPU"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			td := NewTableDataWithRows(
+				client.NewGVR("test"),
+				Header{
+					HeaderColumn{Name: "NAMESPACE"},
+					HeaderColumn{Name: "NAME"},
+					HeaderColumn{Name: "STATUS"},
+					HeaderColumn{Name: "CPU", Attrs: Attrs{MX: true}},
+				},
+				NewRowEventsWithEvts(
+					RowEvent{Row: Row{ID: "ns1/fred", Fields: Fields{"ns1", "fred", "Running", "10"}}},
+				),
+			)
+			err := td.ReorderColumns(u.order)
+			if u.err != "" {
+				require.EqualError(t, err, u.err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, u.eCols, td.ColumnNames(true))
+			re, ok := td.RowAt(0)
+			require.True(t, ok)
+			assert.Equal(t, u.e, re.Row.Fields)
+			assert.Equal(t, "ns1/fred", re.Row.ID)
+		})
+	}
+}
+
+func TestTableDataUpdateRowEqualFn(t *testing.T) {
+	uu := map[string]struct {
+		fn   RowEqualFn
+		rr   Rows
+		e    UpdateCounts
+		kind ResEvent
+	}{
+		"default": {
+			rr:   Rows{Row{ID: "A", Fields: Fields{"Running", "10"}}},
+			e:    UpdateCounts{Changed: 1},
+			kind: EventUpdate,
+		},
+		"custom-equal": {
+			fn:   func(a, b Row) bool { return a.Fields[0] == b.Fields[0] },
+			rr:   Rows{Row{ID: "A", Fields: Fields{"Running", "10"}}},
+			kind: EventUnchanged,
+		},
+		"custom-changed": {
+			fn:   func(a, b Row) bool { return a.Fields[0] == b.Fields[0] },
+			rr:   Rows{Row{ID: "A", Fields: Fields{"Failed", "1"}}},
+			e:    UpdateCounts{Changed: 1},
+			kind: EventUpdate,
+		},
+	}
+
+	gvr := client.NewGVR("test/v1/equals")
+	defer RegisterRowEqualFn(gvr, nil)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			RegisterRowEqualFn(gvr, u.fn)
+			table := NewTableDataWithRows(
+				gvr,
+				Header{HeaderColumn{Name: "STATUS"}, HeaderColumn{Name: "AGE"}},
+				NewRowEventsWithEvts(
+					RowEvent{Row: Row{ID: "A", Fields: Fields{"Running", "1"}}},
+				),
+			)
+			assert.Equal(t, u.e, table.Update(u.rr))
+			re, ok := table.FindRow("A")
+			require.True(t, ok)
+			assert.Equal(t, u.kind, re.Kind)
+			assert.Equal(t, u.rr[0], re.Row)
+		})
+	}
+}
+
+func TestParseSortColumn(t *testing.T) {
+	uu := map[string]struct {
+		spec string
+		e    SortColumn
+		err  string
+	}{
+		"no-dir": {
+			spec: "CPU",
+			e:    SortColumn{Name: "CPU", ASC: true},
+		},
+		"asc": {
+			spec: "CPU:asc",
+			e:    SortColumn{Name: "CPU", ASC: true},
+		},
+		"desc": {
+			spec: "CPU:desc",
+			e:    SortColumn{Name: "CPU"},
+		},
+		"upper-dir": {
+			spec: "CPU:DESC",
+			e:    SortColumn{Name: "CPU"},
+		},
+		"bad-dir": {
+			spec: "CPU:up",
+			err:  `invalid sort direction "up". must be one of asc or desc`,
+		},
+		"empty-dir": {
+			spec: "CPU:",
+			err:  `invalid sort direction "". must be one of asc or desc`,
+		},
+		"no-name": {
+			spec: ":asc",
+			err:  `invalid sort column spec: ":asc". must be col-name[:asc|desc]`,
+		},
+		"empty": {
+			err: `invalid sort column spec: "". must be col-name[:asc|desc]`,
+		},
+		"too-many": {
+			spec: "CPU:asc:desc",
+			err:  `invalid sort column spec: "CPU:asc:desc". must be col-name[:asc|desc]`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			sc, err := ParseSortColumn(u.spec)
+			if u.err != "" {
+				require.EqualError(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.e, sc)
+		})
+	}
+}
+
+func TestSortColumnString(t *testing.T) {
+	uu := map[string]struct {
+		sc SortColumn
+		e  string
+	}{
+		"asc": {
+			sc: SortColumn{Name: "CPU", ASC: true},
+			e:  "CPU:asc",
+		},
+		"desc": {
+			sc: SortColumn{Name: "CPU"},
+			e:  "CPU:desc",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.sc.String())
+			sc, err := ParseSortColumn(u.sc.String())
+			require.NoError(t, err)
+			assert.Equal(t, u.sc, sc)
+		})
+	}
+}
+
+func TestTableDataFingerprint(t *testing.T) {
+	h := Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "CPU", Attrs: Attrs{MX: true}},
+		HeaderColumn{Name: "AGE", Attrs: Attrs{Time: true}},
+	}
+	base := NewTableDataWithRows(client.NewGVR("test"), h, NewRowEventsWithEvts(
+		RowEvent{Row: Row{ID: "A", Fields: Fields{"a", "10", "1m"}}},
+		RowEvent{Row: Row{ID: "B", Fields: Fields{"b", "20", "2m"}}},
+	))
+
+	uu := map[string]struct {
+		re           *RowEvents
+		skipVolatile bool
+		e            bool
+	}{
+		"same": {
+			re: NewRowEventsWithEvts(
+				RowEvent{Row: Row{ID: "A", Fields: Fields{"a", "10", "1m"}}},
+				RowEvent{Row: Row{ID: "B", Fields: Fields{"b", "20", "2m"}}},
+			),
+			e: true,
+		},
+		"field-changed": {
+			re: NewRowEventsWithEvts(
+				RowEvent{Row: Row{ID: "A", Fields: Fields{"a", "10", "1m"}}},
+				RowEvent{Row: Row{ID: "B", Fields: Fields{"bb", "20", "2m"}}},
+			),
+		},
+		"volatile-changed": {
+			re: NewRowEventsWithEvts(
+				RowEvent{Row: Row{ID: "A", Fields: Fields{"a", "11", "2m"}}},
+				RowEvent{Row: Row{ID: "B", Fields: Fields{"b", "21", "3m"}}},
+			),
+		},
+		"volatile-skipped": {
+			re: NewRowEventsWithEvts(
+				RowEvent{Row: Row{ID: "A", Fields: Fields{"a", "11", "2m"}}},
+				RowEvent{Row: Row{ID: "B", Fields: Fields{"b", "21", "3m"}}},
+			),
+			skipVolatile: true,
+			e:            true,
+		},
+		"row-boundaries": {
+			re: NewRowEventsWithEvts(
+				RowEvent{Row: Row{ID: "A", Fields: Fields{"a", "10", "1m", "B", "b", "20", "2m"}}},
+			),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			td := NewTableDataWithRows(client.NewGVR("test"), h, u.re)
+			assert.Equal(t, u.e, base.Fingerprint(u.skipVolatile) == td.Fingerprint(u.skipVolatile))
+		})
+	}
+}
+
+func TestTableDataFingerprintStable(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{HeaderColumn{Name: "NAME"}, HeaderColumn{Name: "STATUS"}},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "A", Fields: Fields{"a", "Running"}}},
+		),
+	)
+
+	assert.Equal(t, uint64(0x8ad096db92875e50), td.Fingerprint(false))
+}
+
+func BenchmarkTableDataFingerprint(b *testing.B) {
+	t1, t2 := benchTable(1_000), benchTable(1_000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		_ = t1.Fingerprint(true) == t2.Fingerprint(true)
+	}
+}
+
+func BenchmarkTableDataDiff(b *testing.B) {
+	t1, t2 := benchTable(1_000), benchTable(1_000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		_ = t1.Diff(t2)
+	}
+}
+
+func benchTable(n int) *TableData {
+	re := NewRowEvents(n)
+	for i := range n {
+		id := fmt.Sprintf("ns/pod-%d", i)
+		re.Add(NewRowEvent(EventAdd, Row{ID: id, Fields: Fields{"ns", id, "1/1", "Running", "0", "10", "20", "5m"}}))
+	}
+
+	return NewTableDataWithRows(
+		client.NewGVR("v1/pods"),
+		Header{
+			HeaderColumn{Name: "NAMESPACE"},
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "READY"},
+			HeaderColumn{Name: "STATUS"},
+			HeaderColumn{Name: "RESTARTS"},
+			HeaderColumn{Name: "CPU", Attrs: Attrs{MX: true}},
+			HeaderColumn{Name: "MEM", Attrs: Attrs{MX: true}},
+			HeaderColumn{Name: "AGE", Attrs: Attrs{Time: true}},
+		},
+		re,
+	)
+}
+
+func TestTableDataDecorations(t *testing.T) {
+	statusDecorator := func(row Row) map[int]Decoration {
+		switch row.Fields[1] {
+		case "CrashLoopBackOff", "Error":
+			return map[int]Decoration{1: DecorationError}
+		case "Pending":
+			return map[int]Decoration{1: DecorationPending}
+		default:
+			return nil
+		}
+	}
+
+	uu := map[string]struct {
+		fn DecorateFn
+		rr Rows
+		e  map[string]map[int]Decoration
+	}{
+		"none": {
+			rr: Rows{
+				Row{ID: "A", Fields: Fields{"a", "CrashLoopBackOff"}},
+			},
+			e: map[string]map[int]Decoration{"A": nil},
+		},
+		"status": {
+			fn: statusDecorator,
+			rr: Rows{
+				Row{ID: "A", Fields: Fields{"a", "CrashLoopBackOff"}},
+				Row{ID: "B", Fields: Fields{"b", "Running"}},
+				Row{ID: "C", Fields: Fields{"c", "Pending"}},
+			},
+			e: map[string]map[int]Decoration{
+				"A": {1: DecorationError},
+				"B": nil,
+				"C": {1: DecorationPending},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			td := NewTableDataWithRows(
+				client.NewGVR("v1/pods"),
+				Header{HeaderColumn{Name: "NAME"}, HeaderColumn{Name: "STATUS"}},
+				NewRowEventsWithEvts(
+					RowEvent{Row: Row{ID: "A", Fields: Fields{"a", "Running"}}},
+				),
+			)
+			td.SetDecorateFn(u.fn)
+			td.Update(u.rr)
+			for id, e := range u.e {
+				re, ok := td.FindRow(id)
+				require.True(t, ok)
+				assert.Equal(t, e, re.Decorations)
+			}
+		})
+	}
+}
+
+func TestRowEventCustomizeDecorations(t *testing.T) {
+	re := RowEvent{
+		Row:         Row{ID: "A", Fields: Fields{"a", "CrashLoopBackOff", "10"}},
+		Decorations: map[int]Decoration{1: DecorationError, 2: DecorationHighlight},
+	}
+
+	c := re.Customize([]int{1, 0})
+	d, ok := c.Decoration(0)
+	assert.True(t, ok)
+	assert.Equal(t, DecorationError, d)
+	_, ok = c.Decoration(1)
+	assert.False(t, ok)
+	assert.Equal(t, ErrColor, d.Color())
+}
+
+func TestTableDataFilterAge(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "AGE", Attrs: Attrs{Time: true}},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "a", Fields: Fields{"a", "30s"}}},
+			RowEvent{Row: Row{ID: "b", Fields: Fields{"b", "5m10s"}}},
+			RowEvent{Row: Row{ID: "c", Fields: Fields{"c", "2h"}}},
+			RowEvent{Row: Row{ID: "d", Fields: Fields{"d", "3d4h"}}},
+			RowEvent{Row: Row{ID: "e", Fields: Fields{"e", "2y10d"}}},
+			RowEvent{Row: Row{ID: "f", Fields: Fields{"f", "<unknown>"}}},
+		),
+	)
+
+	uu := map[string]struct {
+		q      string
+		invert bool
+		e      []string
+	}{
+		"older": {
+			q: "age>1h",
+			e: []string{"c", "d", "e"},
+		},
+		"younger": {
+			q: "age<5m",
+			e: []string{"a"},
+		},
+		"days": {
+			q: "age>=3d4h",
+			e: []string{"d", "e"},
+		},
+		"fractional-days": {
+			q: "age<1.5d",
+			e: []string{"a", "b", "c"},
+		},
+		"le": {
+			q: "age<=5m10s",
+			e: []string{"a", "b"},
+		},
+		"inverse": {
+			q: "!age>1h",
+			e: []string{"a", "b"},
+		},
+		"invert-opt": {
+			q:      "age>1h",
+			invert: true,
+			e:      []string{"a", "b"},
+		},
+		"bad-duration": {
+			q: "age>fred",
+			e: []string{"a", "b", "c", "d", "e", "f"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, rowIDs(td.Filter(FilterOpts{Filter: u.q, Invert: u.invert})))
+		})
+	}
+}
+
+func TestTableDataSince(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "AGE", Attrs: Attrs{Time: true}},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred", "30s"}}},
+			RowEvent{Row: Row{ID: "blee", Fields: Fields{"blee", "10m"}}},
+			RowEvent{Row: Row{ID: "zorg", Fields: Fields{"zorg", "2d"}}},
+			RowEvent{Row: Row{ID: "duh", Fields: Fields{"duh", "<unknown>"}}},
+		),
+	)
+
+	uu := map[string]struct {
+		d time.Duration
+		e []string
+	}{
+		"none": {
+			d: 10 * time.Second,
+			e: []string{},
+		},
+		"seconds": {
+			d: 30 * time.Second,
+			e: []string{"fred"},
+		},
+		"minutes": {
+			d: 10 * time.Minute,
+			e: []string{"fred", "blee"},
+		},
+		"days": {
+			d: 48 * time.Hour,
+			e: []string{"fred", "blee", "zorg"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, rowIDs(td.Since(u.d)))
+		})
+	}
+}
+
+func TestTableDataFilterAgeNoAgeCol(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{HeaderColumn{Name: "NAME"}},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "a", Fields: Fields{"a"}}},
+		),
+	)
+
+	assert.Empty(t, rowIDs(td.Filter(FilterOpts{Filter: "age>1h"})))
+}
+
+func TestTableDataMerge(t *testing.T) {
+	h := Header{HeaderColumn{Name: "NAMESPACE"}, HeaderColumn{Name: "NAME"}}
+	gvr := client.NewGVR("v1/pods")
+
+	uu := map[string]struct {
+		others []*TableData
+		e      []string
+		err    string
+	}{
+		"merge": {
+			others: []*TableData{
+				NewTableDataFull(gvr, "ns2", h, NewRowEventsWithEvts(
+					RowEvent{Row: Row{ID: "ns2/c", Fields: Fields{"ns2", "c"}}},
+					RowEvent{Row: Row{ID: "ns1/a", Fields: Fields{"ns1", "a"}}},
+				)),
+				NewTableDataFull(gvr, "ns3", h, NewRowEventsWithEvts(
+					RowEvent{Row: Row{ID: "ns3/d", Fields: Fields{"ns3", "d"}}},
+				)),
+				nil,
+			},
+			e: []string{"ns1/a", "ns1/b", "ns2/c", "ns3/d"},
+		},
+		"gvr-mismatch": {
+			others: []*TableData{
+				NewTableDataFull(client.NewGVR("v1/services"), "ns2", h, NewRowEvents(0)),
+			},
+			err: "unable to merge v1/services into v1/pods",
+			e:   []string{"ns1/a", "ns1/b"},
+		},
+		"header-mismatch": {
+			others: []*TableData{
+				NewTableDataFull(gvr, "ns2", Header{HeaderColumn{Name: "NAME"}}, NewRowEvents(0)),
+			},
+			err: "unable to merge v1/pods tables with different headers",
+			e:   []string{"ns1/a", "ns1/b"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			td := NewTableDataFull(gvr, "ns1", h, NewRowEventsWithEvts(
+				RowEvent{Row: Row{ID: "ns1/a", Fields: Fields{"ns1", "a"}}},
+				RowEvent{Row: Row{ID: "ns1/b", Fields: Fields{"ns1", "b"}}},
+			))
+			err := td.Merge(u.others...)
+			if u.err != "" {
+				require.EqualError(t, err, u.err)
+				assert.Equal(t, "ns1", td.GetNamespace())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, client.NamespaceAll, td.GetNamespace())
+			}
+			assert.Equal(t, u.e, rowIDs(td))
+		})
+	}
+}
+
+func TestTableDataColumnMeta(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("v1/pods"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "CPU", Attrs: Attrs{MX: true}},
+			HeaderColumn{Name: "IP", Attrs: Attrs{Wide: true}},
+			HeaderColumn{Name: "SIZE", Attrs: Attrs{Capacity: true, Wide: true}},
+			HeaderColumn{Name: "AGE", Attrs: Attrs{Time: true}},
+		},
+		NewRowEvents(0),
+	)
+
+	mm := td.ColumnMeta()
+	assert.Equal(t, []ColumnMeta{
+		{Name: "NAME"},
+		{Name: "CPU", MX: true},
+		{Name: "IP", Wide: true},
+		{Name: "SIZE", Capacity: true, Wide: true},
+		{Name: "AGE", Time: true},
+	}, mm)
+
+	mm[0].Name, mm[1].MX = "BLEE", false
+	assert.Equal(t, "NAME", td.Header()[0].Name)
+	assert.True(t, td.Header()[1].MX)
+}
+
+func TestTableDataSlice(t *testing.T) {
+	td := NewTableDataFull(
+		client.NewGVR("v1/pods"),
+		"",
+		Header{HeaderColumn{Name: "NAME"}},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "a", Fields: Fields{"a"}}},
+			RowEvent{Row: Row{ID: "b", Fields: Fields{"b"}}},
+			RowEvent{Row: Row{ID: "c", Fields: Fields{"c"}}},
+		),
+	)
+
+	uu := map[string]struct {
+		offset, limit int
+		e             []string
+	}{
+		"all": {
+			limit: 10,
+			e:     []string{"a", "b", "c"},
+		},
+		"window": {
+			offset: 1,
+			limit:  1,
+			e:      []string{"b"},
+		},
+		"tail": {
+			offset: 2,
+			limit:  5,
+			e:      []string{"c"},
+		},
+		"out-of-range": {
+			offset: 3,
+			limit:  1,
+		},
+		"negative": {
+			offset: -1,
+			limit:  1,
+		},
+		"no-limit": {},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ee := td.Slice(u.offset, u.limit)
+			ids := make([]string, 0, len(ee))
+			for _, e := range ee {
+				ids = append(ids, e.Row.ID)
+			}
+			if u.e == nil {
+				assert.Empty(t, ids)
+				return
+			}
+			assert.Equal(t, u.e, ids)
+		})
+	}
+}
+
+func TestTableDataSliceCopy(t *testing.T) {
+	td := NewTableDataFull(
+		client.NewGVR("v1/pods"),
+		"",
+		Header{HeaderColumn{Name: "NAME"}},
+		NewRowEventsWithEvts(RowEvent{Row: Row{ID: "a", Fields: Fields{"a"}}}),
+	)
+
+	ee := td.Slice(0, 1)
+	require.Len(t, ee, 1)
+	ee[0].Row.Fields[0] = "blee"
+
+	re, ok := td.FindRow("a")
+	require.True(t, ok)
+	assert.Equal(t, "a", re.Row.Fields[0])
+}
+
+func TestTableDataFuzzyOnFields(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("v1/pods"),
+		Header{
+			HeaderColumn{Name: "NAMESPACE"},
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "STATUS"},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "web/fred", Fields: Fields{"web", "fred", "Running"}}},
+			RowEvent{Row: Row{ID: "default/webapp", Fields: Fields{"default", "webapp", "Running"}}},
+		),
+	)
+	td.namespace = "web"
+
+	uu := map[string]struct {
+		opts FilterOpts
+		e    []string
+	}{
+		"id": {
+			opts: FilterOpts{Filter: "-f web"},
+			e:    []string{"web/fred", "default/webapp"},
+		},
+		"fields": {
+			opts: FilterOpts{Filter: "-f web", FuzzyOnFields: true},
+			e:    []string{"default/webapp"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ids := rowIDs(td.Filter(u.opts))
+			slices.Sort(ids)
+			slices.Sort(u.e)
+			assert.Equal(t, u.e, ids)
+		})
+	}
+}
+
+func TestTableDataFilterKV(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("apps/v1/deployments"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "LABELS", Attrs: Attrs{Wide: true}},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred", "app=fred,env=prod"}}},
+			RowEvent{Row: Row{ID: "blee", Fields: Fields{"blee", "app=blee,env=production"}}},
+			RowEvent{Row: Row{ID: "zorg", Fields: Fields{"zorg", "app=zorg"}}},
+		),
+	)
+
+	uu := map[string]struct {
+		opts FilterOpts
+		e    []string
+	}{
+		"exact": {
+			opts: FilterOpts{Filter: "env=prod"},
+			e:    []string{"fred"},
+		},
+		"wildcard": {
+			opts: FilterOpts{Filter: "env=*"},
+			e:    []string{"fred", "blee"},
+		},
+		"wildcard-prefix": {
+			opts: FilterOpts{Filter: "env=prod*"},
+			e:    []string{"fred", "blee"},
+		},
+		"multi": {
+			opts: FilterOpts{Filter: "app=blee,env=*"},
+			e:    []string{"blee"},
+		},
+		"no-key": {
+			opts: FilterOpts{Filter: "tier=web"},
+			e:    []string{},
+		},
+		"inverse": {
+			opts: FilterOpts{Filter: "!env=prod"},
+			e:    []string{"blee", "zorg"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, rowIDs(td.Filter(u.opts)))
+		})
+	}
+}
+
+func TestTableDataFilterHas(t *testing.T) {
+	const lac = "kubectl.kubernetes.io/last-applied-configuration"
+	td := NewTableDataWithRows(
+		client.NewGVR("v1/pods"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "LABELS", Attrs: Attrs{Wide: true}},
+			HeaderColumn{Name: "ANNOTATIONS", Attrs: Attrs{Wide: true}},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred", "app=fred", lac + `={"a":1,"b":2}`}}},
+			RowEvent{Row: Row{ID: "blee", Fields: Fields{"blee", "app=blee,canary=", "team=b"}}},
+			RowEvent{Row: Row{ID: "zorg", Fields: Fields{"zorg", "", ""}}},
+		),
+	)
+
+	uu := map[string]struct {
+		opts FilterOpts
+		e    []string
+	}{
+		"annotation": {
+			opts: FilterOpts{Filter: "has:" + lac},
+			e:    []string{"fred"},
+		},
+		"label": {
+			opts: FilterOpts{Filter: "has:app"},
+			e:    []string{"fred", "blee"},
+		},
+		"blank-value": {
+			opts: FilterOpts{Filter: "has:canary"},
+			e:    []string{"blee"},
+		},
+		"absent": {
+			opts: FilterOpts{Filter: "has:tier"},
+			e:    []string{},
+		},
+		"value-not-key": {
+			opts: FilterOpts{Filter: `has:"b":2}`},
+			e:    []string{},
+		},
+		"inverse": {
+			opts: FilterOpts{Filter: "!has:" + lac},
+			e:    []string{"blee", "zorg"},
+		},
+		"invert": {
+			opts: FilterOpts{Filter: "has:app", Invert: true},
+			e:    []string{"zorg"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, rowIDs(td.Filter(u.opts)))
+		})
+	}
+}
+
+func TestTableDataFilterKVNoLabels(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("v1/pods"),
+		Header{HeaderColumn{Name: "NAME"}},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred"}}},
+		),
+	)
+
+	assert.Equal(t, []string{"fred"}, rowIDs(td.Filter(FilterOpts{Filter: "env=prod"})))
+}
+
+func TestTableDataFilterClientLabels(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("apps/v1/deployments"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "LABELS", Attrs: Attrs{Wide: true}},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred", "app=fred,env=prod,tier=web"}}},
+			RowEvent{Row: Row{ID: "blee", Fields: Fields{"blee", "app=blee,env=dev"}}},
+			RowEvent{Row: Row{ID: "zorg", Fields: Fields{"zorg", "app=zorg"}}},
+		),
+	)
+
+	uu := map[string]struct {
+		opts FilterOpts
+		e    []string
+	}{
+		"server-side": {
+			opts: FilterOpts{Filter: "-l env in (prod)"},
+			e:    []string{"fred", "blee", "zorg"},
+		},
+		"equality": {
+			opts: FilterOpts{Filter: "-l app=fred", ClientLabels: true},
+			e:    []string{"fred"},
+		},
+		"set": {
+			opts: FilterOpts{Filter: "-l env in (prod,dev)", ClientLabels: true},
+			e:    []string{"fred", "blee"},
+		},
+		"not-in": {
+			opts: FilterOpts{Filter: "-l env notin (prod)", ClientLabels: true},
+			e:    []string{"blee", "zorg"},
+		},
+		"absent": {
+			opts: FilterOpts{Filter: "-l !tier", ClientLabels: true},
+			e:    []string{"blee", "zorg"},
+		},
+		"multi": {
+			opts: FilterOpts{Filter: "-l env,app!=blee", ClientLabels: true},
+			e:    []string{"fred"},
+		},
+		"invalid": {
+			opts: FilterOpts{Filter: "-l env in (", ClientLabels: true},
+			e:    []string{"fred", "blee", "zorg"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, rowIDs(td.Filter(u.opts)))
+		})
+	}
+}
+
+func TestTableDataValidate(t *testing.T) {
+	uu := map[string]struct {
+		rows *RowEvents
+		e    []string
+	}{
+		"ok": {
+			rows: NewRowEventsWithEvts(
+				RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred", "1"}}},
+				RowEvent{Row: Row{ID: "blee", Fields: Fields{"blee", "2"}}},
+			),
+		},
+		"short": {
+			rows: NewRowEventsWithEvts(
+				RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred"}}},
+				RowEvent{Row: Row{ID: "blee", Fields: Fields{"blee", "2"}}},
+			),
+			e: []string{`row "fred" has 1 fields, expected 2`},
+		},
+		"long": {
+			rows: NewRowEventsWithEvts(
+				RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred", "1"}}},
+				RowEvent{Row: Row{ID: "blee", Fields: Fields{"blee", "2", "3"}}},
+			),
+			e: []string{`row "blee" has 3 fields, expected 2`},
+		},
+		"dups": {
+			rows: NewRowEventsWithEvts(
+				RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred", "1"}}},
+				RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred", "2"}}},
+			),
+			e: []string{`duplicate row id "fred"`},
+		},
+		"both": {
+			rows: NewRowEventsWithEvts(
+				RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred", "1"}}},
+				RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred"}}},
+			),
+			e: []string{
+				`row "fred" has 1 fields, expected 2`,
+				`duplicate row id "fred"`,
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			td := NewTableDataWithRows(
+				client.NewGVR("v1/pods"),
+				Header{HeaderColumn{Name: "NAME"}, HeaderColumn{Name: "RESTARTS"}},
+				u.rows,
+			)
+			err := td.Validate()
+			if len(u.e) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, strings.Join(u.e, "\n"), err.Error())
+		})
+	}
+}
+
+func TestTableDataAggregate(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("v1/pods"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "CPU", Attrs: Attrs{MX: true}},
+			HeaderColumn{Name: "MEM", Attrs: Attrs{MX: true}},
+			HeaderColumn{Name: "CAPACITY", Attrs: Attrs{Capacity: true}},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred", "100", "1,024", "1Gi"}}},
+			RowEvent{Row: Row{ID: "blee", Fields: Fields{"blee", "250", "512", "512Mi"}}},
+			RowEvent{Row: Row{ID: "zorg", Fields: Fields{"zorg", "n/a", "", "<none>"}}},
+		),
+	)
+
+	uu := map[string]struct {
+		cols []string
+		e    map[string]string
+		err  string
+	}{
+		"metrics": {
+			cols: []string{"CPU", "MEM"},
+			e:    map[string]string{"CPU": "350", "MEM": "1536"},
+		},
+		"capacity": {
+			cols: []string{"CAPACITY"},
+			e:    map[string]string{"CAPACITY": "1536Mi"},
+		},
+		"none": {
+			e: map[string]string{},
+		},
+		"not-numeric": {
+			cols: []string{"CPU", "NAME"},
+			err:  `column "NAME" is not numeric`,
+		},
+		"unknown": {
+			cols: []string{"ZORG"},
+			err:  `unknown column "ZORG"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			totals, err := td.Aggregate(u.cols)
+			if u.err != "" {
+				require.EqualError(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.e, totals)
+		})
+	}
+}
+
+func TestTableDataAggregateInvalid(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("v1/pods"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "CPU", Attrs: Attrs{MX: true}},
+			HeaderColumn{Name: "CAPACITY", Attrs: Attrs{Capacity: true}},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred", "1m", "lots"}}},
+		),
+	)
+
+	_, err := td.Aggregate([]string{"CPU"})
+	require.ErrorContains(t, err, `aggregate "CPU" failed: row "fred"`)
+	_, err = td.Aggregate([]string{"CAPACITY"})
+	require.ErrorContains(t, err, `aggregate "CAPACITY" failed: row "fred"`)
+}
+
+func TestTableDataGroupBy(t *testing.T) {
+	td := NewTableData(client.NewGVR("v1/pods"))
+	td.GroupBy("NODE")
+	td.Update(Rows{
+		{ID: "ns/p1", Fields: Fields{"p1", "node-10"}},
+		{ID: "ns/p2", Fields: Fields{"p2", "node-2"}},
+		{ID: "ns/p3", Fields: Fields{"p3", "node-10"}},
+	})
+	td.SetHeader("ns", Header{HeaderColumn{Name: "NAME"}, HeaderColumn{Name: "NODE"}})
+
+	assert.Equal(t, []string{"node-2", "node-10"}, td.Groups())
+	assert.Equal(t, []string{"ns/p1", "ns/p3"}, groupIDs(td.RowsInGroup("node-10")))
+	assert.Equal(t, []string{"ns/p2"}, groupIDs(td.RowsInGroup("node-2")))
+	assert.Empty(t, td.RowsInGroup("zorg"))
+
+	td.Sort(SortColumn{Name: "NAME"})
+	assert.Equal(t, []string{"ns/p3", "ns/p1"}, groupIDs(td.RowsInGroup("node-10")))
+
+	td.Update(Rows{
+		{ID: "ns/p1", Fields: Fields{"p1", "node-2"}},
+		{ID: "ns/p3", Fields: Fields{"p3", "node-10"}},
+		{ID: "ns/p4", Fields: Fields{"p4", "node-3"}},
+	})
+	assert.Equal(t, []string{"node-2", "node-3", "node-10"}, td.Groups())
+	assert.Equal(t, []string{"ns/p1"}, groupIDs(td.RowsInGroup("node-2")))
+
+	c := td.Clone()
+	assert.Equal(t, td.Groups(), c.Groups())
+
+	td.GroupBy("")
+	assert.Nil(t, td.Groups())
+	assert.Nil(t, td.RowsInGroup("node-2"))
+	assert.Equal(t, []string{"node-2", "node-3", "node-10"}, c.Groups())
+}
+
+func TestTableDataGroupByReorder(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("v1/pods"),
+		Header{HeaderColumn{Name: "NAME"}, HeaderColumn{Name: "NODE"}},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "ns/p1", Fields: Fields{"p1", "node-1"}}},
+			RowEvent{Row: Row{ID: "ns/p2", Fields: Fields{"p2", "node-2"}}},
+		),
+	)
+	td.GroupBy("NODE")
+	require.NoError(t, td.ReorderColumns([]string{"NODE"}))
+
+	assert.Equal(t, []string{"node-1", "node-2"}, td.Groups())
+	assert.Equal(t, []string{"ns/p2"}, groupIDs(td.RowsInGroup("node-2")))
+}
+
+func TestTableDataGroupByUnknown(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("v1/pods"),
+		Header{HeaderColumn{Name: "NAME"}},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred"}}},
+		),
+	)
+	td.GroupBy("NODE")
+
+	assert.Nil(t, td.Groups())
+}
+
+func groupIDs(ee []RowEvent) []string {
+	ids := make([]string, 0, len(ee))
+	for _, e := range ee {
+		ids = append(ids, e.Row.ID)
+	}
+
+	return ids
+}
+
+func TestTableDataTopN(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("v1/pods"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "CPU", Attrs: Attrs{MX: true}},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "a", Fields: Fields{"a", "100m"}}},
+			RowEvent{Row: Row{ID: "b", Fields: Fields{"b", "2"}}},
+			RowEvent{Row: Row{ID: "c", Fields: Fields{"c", "n/a"}}},
+			RowEvent{Row: Row{ID: "d", Fields: Fields{"d", "500m"}}},
+			RowEvent{Row: Row{ID: "e", Fields: Fields{"e", "500m"}}},
+			RowEvent{Row: Row{ID: "f", Fields: Fields{"f", "1,500"}}},
+			RowEvent{Row: Row{ID: "g", Fields: Fields{"g"}}},
+		),
+	)
+
+	uu := map[string]struct {
+		col  string
+		n    int
+		desc bool
+		e    []string
+	}{
+		"top-3": {
+			col:  "CPU",
+			n:    3,
+			desc: true,
+			e:    []string{"f", "b", "d"},
+		},
+		"bottom-3": {
+			col: "CPU",
+			n:   3,
+			e:   []string{"a", "d", "e"},
+		},
+		"all": {
+			col:  "CPU",
+			n:    10,
+			desc: true,
+			e:    []string{"f", "b", "d", "e", "a", "c", "g"},
+		},
+		"short-row-last": {
+			col: "CPU",
+			n:   10,
+			e:   []string{"a", "d", "e", "b", "f", "c", "g"},
+		},
+		"none": {
+			col: "CPU",
+			e:   []string{},
+		},
+		"unknown": {
+			col: "MEM",
+			n:   3,
+			e:   []string{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, rowIDs(td.TopN(u.col, u.n, u.desc)))
+		})
+	}
+	assert.Equal(t, 7, td.RowCount())
+}
+
+func TestTableDataRenameColumn(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("v1/pods"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "%CPU/R"},
+			HeaderColumn{Name: "IP", Attrs: Attrs{Wide: true}},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred", "10", "10.0.0.1"}}},
+		),
+	)
+
+	td.RenameColumn("%CPU/R", "CPU REQ%")
+	td.RenameColumn("IP", "POD IP")
+	assert.Equal(t, []string{"NAME", "CPU REQ%"}, td.DisplayNames(false))
+	assert.Equal(t, []string{"NAME", "CPU REQ%", "POD IP"}, td.DisplayNames(true))
+	assert.Equal(t, []string{"NAME", "%CPU/R", "IP"}, td.ColumnNames(true))
+
+	idx, ok := td.IndexOfHeader("%CPU/R")
+	assert.True(t, ok)
+	assert.Equal(t, 1, idx)
+	_, ok = td.IndexOfHeader("CPU REQ%")
+	assert.False(t, ok)
+	assert.Equal(t, []string{"NAME", "CPU REQ%", "POD IP"}, td.Clone().DisplayNames(true))
+
+	td.RenameColumn("IP", "")
+	assert.Equal(t, []string{"NAME", "CPU REQ%", "IP"}, td.DisplayNames(true))
+}
+
+func TestTableDataRenderNilGVR(t *testing.T) {
+	r := &testRenderer{
+		header: Header{HeaderColumn{Name: "NAME"}, HeaderColumn{Name: "STATUS"}},
+		status: "ok",
+	}
+	td := NewTableData(nil)
+
+	require.ErrorIs(t, td.Render(context.Background(), r, []runtime.Object{testObj("fred", "1")}), errNilGVR)
+	assert.EqualError(t, td.Render(context.Background(), r, nil), "table data requires a non-nil GVR")
+	assert.True(t, td.Empty())
+}
+
+func BenchmarkTableDataUpdateUnchanged(b *testing.B) {
+	td := NewTableData(client.NewGVR("v1/pods"))
+	td.SetHeader("", Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "RESTARTS"},
+		HeaderColumn{Name: "AGE", Attrs: Attrs{Time: true}},
+	})
+	rows := make(Rows, 0, 10_000)
+	for i := range 10_000 {
+		id := fmt.Sprintf("ns/pod-%d", i)
+		rows = append(rows, Row{ID: id, Fields: Fields{"ns", id, "1/1", "Running", "0", "5m"}})
+	}
+	td.Update(rows)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		td.Update(rows)
+	}
+}
+
+func TestTableDataSnapshot(t *testing.T) {
+	td := NewTableData(client.NewGVR("v1/pods"))
+	td.SetHeader("", Header{HeaderColumn{Name: "NAME"}, HeaderColumn{Name: "STATUS"}})
+	td.Update(Rows{
+		{ID: "fred", Fields: Fields{"fred", "Running"}},
+		{ID: "blee", Fields: Fields{"blee", "Running"}},
+	})
+
+	s := td.Snapshot()
+	td.Update(Rows{
+		{ID: "fred", Fields: Fields{"fred", "Failed"}},
+		{ID: "zorg", Fields: Fields{"zorg", "Running"}},
+	})
+	td.SetHeader("", Header{HeaderColumn{Name: "NAME"}})
+
+	assert.Equal(t, []string{"fred", "blee"}, rowIDs(s))
+	assert.Equal(t, []string{"NAME", "STATUS"}, s.ColumnNames(true))
+	re, ok := s.GetRowEvents().Get("fred")
+	require.True(t, ok)
+	assert.Equal(t, "Running", re.Row.Fields[1])
+	assert.Equal(t, []string{"fred", "zorg"}, rowIDs(td))
+}
+
+func BenchmarkTableDataClone(b *testing.B) {
+	td := benchTable(5_000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		_ = td.Clone()
+	}
+}
+
+func BenchmarkTableDataSnapshot(b *testing.B) {
+	td := benchTable(5_000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		_ = td.Snapshot()
+	}
+}
+
+func TestSameRow(t *testing.T) {
+	uu := map[string]struct {
+		r1, r2 Row
+		e      bool
+	}{
+		"same": {
+			r1: Row{ID: "a", Fields: Fields{"a", "1"}},
+			r2: Row{ID: "a", Fields: Fields{"a", "1"}},
+			e:  true,
+		},
+		"diff-id": {
+			r1: Row{ID: "a", Fields: Fields{"a", "1"}},
+			r2: Row{ID: "b", Fields: Fields{"a", "1"}},
+		},
+		"diff-field": {
+			r1: Row{ID: "a", Fields: Fields{"a", "1"}},
+			r2: Row{ID: "a", Fields: Fields{"a", "2"}},
+		},
+		"diff-len": {
+			r1: Row{ID: "a", Fields: Fields{"a", "1"}},
+			r2: Row{ID: "a", Fields: Fields{"a"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, sameRow(u.r1, u.r2))
+		})
+	}
+}
+
+func TestTableDataChangedOnly(t *testing.T) {
+	td := NewTableData(client.NewGVR("v1/pods"))
+	td.SetHeader("", Header{HeaderColumn{Name: "NAME"}, HeaderColumn{Name: "STATUS"}})
+	td.Update(Rows{
+		{ID: "a", Fields: Fields{"a", "Running"}},
+		{ID: "b", Fields: Fields{"b", "Running"}},
+		{ID: "c", Fields: Fields{"c", "Running"}},
+	})
+	td.Update(Rows{
+		{ID: "a", Fields: Fields{"a", "Running"}},
+		{ID: "b", Fields: Fields{"b", "Error"}},
+		{ID: "d", Fields: Fields{"d", "Pending"}},
+	})
+
+	ctd := td.ChangedOnly()
+	assert.Equal(t, []string{"b", "d", "c"}, rowIDs(ctd))
+	kk := make([]ResEvent, 0, ctd.RowCount())
+	ctd.RowsRange(func(_ int, re RowEvent) bool {
+		kk = append(kk, re.Kind)
+		return true
+	})
+	assert.Equal(t, []ResEvent{EventUpdate, EventAdd, EventDelete}, kk)
+	assert.Equal(t, 3, td.RowCount())
+
+	td.Update(Rows{
+		{ID: "a", Fields: Fields{"a", "Running"}},
+		{ID: "b", Fields: Fields{"b", "Error"}},
+		{ID: "d", Fields: Fields{"d", "Pending"}},
+	})
+	assert.Empty(t, rowIDs(td.ChangedOnly()))
+}
+
+func TestTableDataColumnAlignments(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("v1/pods"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "RESTARTS", Attrs: Attrs{Align: tview.AlignRight}},
+			HeaderColumn{Name: "CPU", Attrs: Attrs{MX: true}},
+			HeaderColumn{Name: "IP", Attrs: Attrs{Wide: true}},
+			HeaderColumn{Name: "SIZE", Attrs: Attrs{Capacity: true, Wide: true}},
+			HeaderColumn{Name: "AGE", Attrs: Attrs{Time: true}},
+		},
+		NewRowEvents(0),
+	)
+
+	assert.Equal(t, []Alignment{AlignLeft, AlignRight, AlignRight, AlignRight}, td.ColumnAlignments(false))
+	assert.Equal(t, []Alignment{AlignLeft, AlignRight, AlignRight, AlignLeft, AlignRight, AlignRight}, td.ColumnAlignments(true))
+	assert.Equal(t, "right", AlignRight.String())
+	assert.Equal(t, "left", AlignLeft.String())
+}
+
+func TestTableDataFilterSpacedValues(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "MESSAGE"},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "a", Fields: Fields{"fred", "back off"}}},
+			RowEvent{Row: Row{ID: "b", Fields: Fields{"blee", "off\tfred"}}},
+		),
+	)
+
+	uu := map[string]struct {
+		opts FilterOpts
+		e    []string
+	}{
+		"within-spaced-value": {
+			opts: FilterOpts{Filter: `back.off`},
+			e:    []string{"a"},
+		},
+		"no-boundary-span": {
+			opts: FilterOpts{Filter: `fred.back`},
+			e:    []string{},
+		},
+		"no-boundary-span-tail": {
+			opts: FilterOpts{Filter: `off.blee`},
+			e:    []string{},
+		},
+		"fuzzy-fields": {
+			opts: FilterOpts{Filter: "-f blee", FuzzyOnFields: true},
+			e:    []string{"b"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, rowIDs(td.Filter(u.opts)))
+		})
+	}
+	assert.NotContains(t, "fred back off", spacer)
+}
+
+func TestTableDataDiffRows(t *testing.T) {
+	h := Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "AGE", Attrs: Attrs{Time: true}},
+	}
+	prev := NewTableDataWithRows(client.NewGVR("v1/pods"), h, NewRowEventsWithEvts(
+		RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred", "Running", "1m"}}},
+		RowEvent{Row: Row{ID: "blee", Fields: Fields{"blee", "Pending", "1m"}}},
+		RowEvent{Row: Row{ID: "zorg", Fields: Fields{"zorg", "Running", "1m"}}},
+		RowEvent{Row: Row{ID: "duh", Fields: Fields{"duh", "", "1m"}}},
+	))
+
+	uu := map[string]struct {
+		prev                    *TableData
+		rows                    []RowEvent
+		added, removed, changed []string
+		deltas                  []DeltaRow
+	}{
+		"unchanged": {
+			prev: prev,
+			rows: []RowEvent{
+				{Row: Row{ID: "fred", Fields: Fields{"fred", "Running", "2m"}}},
+				{Row: Row{ID: "blee", Fields: Fields{"blee", "Pending", "2m"}}},
+				{Row: Row{ID: "zorg", Fields: Fields{"zorg", "Running", "2m"}}},
+				{Row: Row{ID: "duh", Fields: Fields{"duh", "", "2m"}}},
+			},
+		},
+		"added-removed": {
+			prev: prev,
+			rows: []RowEvent{
+				{Row: Row{ID: "bozo", Fields: Fields{"bozo", "Running", "1s"}}},
+				{Row: Row{ID: "fred", Fields: Fields{"fred", "Running", "1m"}}},
+				{Row: Row{ID: "duh", Fields: Fields{"duh", "", "1m"}}},
+				{Row: Row{ID: "abc", Fields: Fields{"abc", "Running", "1s"}}},
+			},
+			added:   []string{"bozo", "abc"},
+			removed: []string{"blee", "zorg"},
+		},
+		"modified": {
+			prev: prev,
+			rows: []RowEvent{
+				{Row: Row{ID: "fred", Fields: Fields{"fred", "Running", "1m"}}},
+				{Row: Row{ID: "duh", Fields: Fields{"duh", "Running", "1m"}}},
+				{Row: Row{ID: "blee", Fields: Fields{"blee", "Running", "1m"}}},
+				{Row: Row{ID: "zorg", Fields: Fields{"zorg", "Running", "1m"}}},
+			},
+			changed: []string{"duh", "blee"},
+			deltas:  []DeltaRow{{"", "", ""}, {"", "Pending", ""}},
+		},
+		"no-prev": {
+			rows: []RowEvent{
+				{Row: Row{ID: "fred", Fields: Fields{"fred", "Running", "1m"}}},
+			},
+			added: []string{"fred"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			td := NewTableDataWithRows(client.NewGVR("v1/pods"), h, NewRowEventsWithEvts(u.rows...))
+			added, removed, changed := td.DiffRows(u.prev)
+			assert.Equal(t, u.added, eventIDs(added))
+			assert.Equal(t, u.removed, eventIDs(removed))
+			assert.Equal(t, u.changed, eventIDs(changed))
+			for i, re := range changed {
+				assert.Equal(t, EventUpdate, re.Kind)
+				assert.Equal(t, u.deltas[i], re.Deltas)
+			}
+			for _, re := range removed {
+				assert.Equal(t, EventDelete, re.Kind)
+			}
+		})
+	}
+}
+
+func TestTableDataDiffRowsCrossed(t *testing.T) {
+	h := Header{HeaderColumn{Name: "NAME"}, HeaderColumn{Name: "STATUS"}}
+	t1 := NewTableDataWithRows(client.NewGVR("v1/pods"), h, NewRowEventsWithEvts(
+		RowEvent{Row: Row{ID: "fred", Fields: Fields{"fred", "Running"}}},
+	))
+	t2 := NewTableDataWithRows(client.NewGVR("v1/pods"), h, NewRowEventsWithEvts(
+		RowEvent{Row: Row{ID: "blee", Fields: Fields{"blee", "Running"}}},
+	))
+
+	var wg sync.WaitGroup
+	for range 100 {
+		wg.Add(3)
+		go func() { defer wg.Done(); t1.DiffRows(t2) }()
+		go func() { defer wg.Done(); t2.DiffRows(t1) }()
+		go func() { defer wg.Done(); t1.SetHeader("", h) }()
+	}
+	wg.Wait()
+
+	added, removed, changed := t1.DiffRows(t1)
+	assert.Empty(t, added)
+	assert.Empty(t, removed)
+	assert.Empty(t, changed)
+}
+
+func eventIDs(ee []RowEvent) []string {
+	if len(ee) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(ee))
+	for _, e := range ee {
+		ids = append(ids, e.Row.ID)
+	}
+
+	return ids
+}
@@ -68,3 +68,10 @@ type Generic interface {
 	// Render renders the resource.
 	Render(o any, ns string, row *Row) error
 }
+
+// VolatileRenderer represents a renderer able to refresh the columns of a resource
+// that may change while its resource version does not ie metrics or ages.
+type VolatileRenderer interface {
+	// RenderVolatile returns the volatile column values keyed by column name.
+	RenderVolatile(o any) (map[string]string, error)
+}
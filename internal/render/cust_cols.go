@@ -91,6 +91,17 @@ func (c ColumnSpecs) isEmpty() bool {
 	return len(c) == 0
 }
 
+// dropCustom removes the values of columns overridden by a custom spec.
+func (cc ColumnSpecs) dropCustom(vv map[string]string) map[string]string {
+	for _, c := range cc {
+		if c.Spec != "" {
+			delete(vv, c.Header.Name)
+		}
+	}
+
+	return vv
+}
+
 // Header builds a new header that is a super set of custom and/or default header.
 func (cc ColumnSpecs) Header(rh model1.Header) model1.Header {
 	hh := make(model1.Header, 0, len(cc))
@@ -4,6 +4,7 @@
 package render
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -42,11 +43,40 @@ func TestTableGenericHydrate(t *testing.T) {
 	var re Table
 	re.SetTable("blee", &tt)
 
-	require.NoError(t, model1.GenericHydrate("blee", &tt, rr, &re))
+	require.NoError(t, model1.GenericHydrate(context.Background(), "blee", &tt, rr, &re))
 	assert.Len(t, rr, 2)
 	assert.Len(t, rr[0].Fields, 2)
 }
 
+func TestTableGenericHydrateTimestamp(t *testing.T) {
+	raw := load(t, "p1")
+	ts := time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339)
+	tt := metav1beta1.Table{
+		ColumnDefinitions: []metav1beta1.TableColumnDefinition{
+			{Name: "Name"},
+			{Name: "Expires", Type: "date"},
+			{Name: "Age", Type: "date"},
+		},
+		Rows: []metav1beta1.TableRow{
+			{
+				Cells:  []any{"fred", ts, ts},
+				Object: runtime.RawExtension{Object: raw},
+			},
+		},
+	}
+	rr := make([]model1.Row, 1)
+	var re Table
+	re.SetTable("blee", &tt)
+
+	require.NoError(t, model1.GenericHydrate(context.Background(), "blee", &tt, rr, &re))
+	assert.Equal(t, model1.Fields{"fred", "120m", "120m"}, rr[0].Fields)
+	assert.Equal(t, model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "EXPIRES", Attrs: model1.Attrs{Time: true}},
+		model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+	}, re.Header("blee"))
+}
+
 func TestTableHydrate(t *testing.T) {
 	oo := []runtime.Object{
 		&PodWithMetrics{Raw: load(t, "p1")},
@@ -54,7 +84,7 @@ func TestTableHydrate(t *testing.T) {
 	rr := make([]model1.Row, 1)
 
 	re := NewPod()
-	require.NoError(t, model1.Hydrate("blee", oo, rr, re))
+	require.NoError(t, model1.Hydrate(context.Background(), "blee", oo, rr, re))
 	assert.Len(t, rr, 1)
 	assert.Len(t, rr[0].Fields, 25)
 }
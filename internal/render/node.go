@@ -17,6 +17,7 @@ import (
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/tview"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -133,6 +134,34 @@ func (n Node) defaultRow(nwm *NodeWithMetrics, r *model1.Row) error {
 	return nil
 }
 
+// RenderVolatile returns the node pods count, metrics and age columns.
+func (n Node) RenderVolatile(o any) (map[string]string, error) {
+	nwm, ok := o.(*NodeWithMetrics)
+	if !ok {
+		return nil, fmt.Errorf("expected NodeWithMetrics, but got %T", o)
+	}
+	var no v1.Node
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(nwm.Raw.Object, &no); err != nil {
+		return nil, err
+	}
+	c, a := gatherNodeMX(&no, nwm.MX)
+	podCount := strconv.Itoa(nwm.PodCount)
+	if nwm.PodCount == -1 {
+		podCount = NAValue
+	}
+
+	return n.specs.dropCustom(map[string]string{
+		"PODS":  podCount,
+		"CPU":   toMc(c.cpu),
+		"MEM":   toMi(c.mem),
+		"%CPU":  client.ToPercentageStr(c.cpu, a.cpu),
+		"%MEM":  client.ToPercentageStr(c.mem, a.mem),
+		"CPU/A": toMc(a.cpu),
+		"MEM/A": toMi(a.mem),
+		"AGE":   ToAge(no.GetCreationTimestamp()),
+	}), nil
+}
+
 // Healthy checks component health.
 func (n Node) Healthy(_ context.Context, o any) error {
 	nwm, ok := o.(*NodeWithMetrics)
@@ -197,6 +226,15 @@ func (n *NodeWithMetrics) DeepCopyObject() runtime.Object {
 	return n
 }
 
+// GetObjectMeta returns the resource metadata.
+func (n *NodeWithMetrics) GetObjectMeta() metav1.Object {
+	if n.Raw == nil {
+		return nil
+	}
+
+	return n.Raw
+}
+
 type metric struct {
 	cpu, mem   int64
 	lcpu, lmem int64
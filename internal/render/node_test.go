@@ -30,6 +30,27 @@ func TestNodeRender(t *testing.T) {
 	assert.Equal(t, e, r.Fields[:17])
 }
 
+func TestNodeRenderVolatile(t *testing.T) {
+	pom := render.NodeWithMetrics{
+		Raw: load(t, "no"),
+		MX:  makeNodeMX("n1", "10m", "20Mi"),
+	}
+
+	var no render.Node
+	r := model1.NewRow(14)
+	require.NoError(t, no.Render(&pom, "", &r))
+	vv, err := no.RenderVolatile(&pom)
+	require.NoError(t, err)
+
+	h := no.Header("")
+	assert.NotEmpty(t, vv)
+	for n, v := range vv {
+		idx, ok := h.IndexOf(n, true)
+		require.True(t, ok, n)
+		assert.Equal(t, r.Fields[idx], v, n)
+	}
+}
+
 func BenchmarkNodeRender(b *testing.B) {
 	var (
 		no  render.Node
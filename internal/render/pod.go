@@ -205,6 +205,40 @@ func (p *Pod) defaultRow(pwm *PodWithMetrics, row *model1.Row) error {
 	return nil
 }
 
+// RenderVolatile returns the pod metrics, vulnerability score and age columns.
+func (p *Pod) RenderVolatile(o any) (map[string]string, error) {
+	pwm, ok := o.(*PodWithMetrics)
+	if !ok {
+		return nil, fmt.Errorf("expected PodWithMetrics, but got %T", o)
+	}
+	var st v1.PodStatus
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(pwm.Raw.Object["status"].(map[string]any), &st); err != nil {
+		return nil, err
+	}
+	spec := new(v1.PodSpec)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(pwm.Raw.Object["spec"].(map[string]any), spec); err != nil {
+		return nil, err
+	}
+	var ccmx []mv1beta1.ContainerMetrics
+	if pwm.MX != nil {
+		ccmx = pwm.MX.Containers
+	}
+	c, r := gatherCoMX(spec, ccmx)
+	_, _, _, lr := p.Statuses(st.ContainerStatuses)
+
+	return p.specs.dropCustom(map[string]string{
+		"VS":           computeVulScore(pwm.Raw.GetNamespace(), pwm.Raw.GetLabels(), spec),
+		"LAST RESTART": ToAge(lr),
+		"CPU":          toMc(c.cpu),
+		"MEM":          toMi(c.mem),
+		"%CPU/R":       client.ToPercentageStr(c.cpu, r.cpu),
+		"%CPU/L":       client.ToPercentageStr(c.cpu, r.lcpu),
+		"%MEM/R":       client.ToPercentageStr(c.mem, r.mem),
+		"%MEM/L":       client.ToPercentageStr(c.mem, r.lmem),
+		"AGE":          ToAge(pwm.Raw.GetCreationTimestamp()),
+	}), nil
+}
+
 // Healthy checks component health.
 func (p Pod) Healthy(_ context.Context, o any) error {
 	pwm, ok := o.(*PodWithMetrics)
@@ -294,6 +328,15 @@ func (p *PodWithMetrics) DeepCopyObject() runtime.Object {
 	return p
 }
 
+// GetObjectMeta returns the resource metadata.
+func (p *PodWithMetrics) GetObjectMeta() metav1.Object {
+	if p.Raw == nil {
+		return nil
+	}
+
+	return p.Raw
+}
+
 func gatherCoMX(spec *v1.PodSpec, ccmx []mv1beta1.ContainerMetrics) (c, r metric) {
 	cc := make([]v1.Container, 0, len(spec.InitContainers)+len(spec.Containers))
 	cc = append(cc, filterSidecarCO(spec.InitContainers)...)
@@ -168,6 +168,27 @@ func TestPodRender(t *testing.T) {
 	assert.Equal(t, e, r.Fields[:20])
 }
 
+func TestPodRenderVolatile(t *testing.T) {
+	pom := render.PodWithMetrics{
+		Raw: load(t, "po"),
+		MX:  makePodMX("nginx", "100m", "50Mi"),
+	}
+
+	po := render.NewPod()
+	r := model1.NewRow(14)
+	require.NoError(t, po.Render(&pom, "", &r))
+	vv, err := po.RenderVolatile(&pom)
+	require.NoError(t, err)
+
+	h := po.Header("")
+	assert.NotEmpty(t, vv)
+	for n, v := range vv {
+		idx, ok := h.IndexOf(n, true)
+		require.True(t, ok, n)
+		assert.Equal(t, r.Fields[idx], v, n)
+	}
+}
+
 func BenchmarkPodRender(b *testing.B) {
 	pom := render.PodWithMetrics{
 		Raw: load(b, "po"),
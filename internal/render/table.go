@@ -9,6 +9,7 @@ import (
 	"log/slog"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/model1"
@@ -69,11 +70,11 @@ func (t *Table) defaultHeader() model1.Header {
 	}
 	h := make(model1.Header, 0, len(t.table.ColumnDefinitions))
 	for i, c := range t.table.ColumnDefinitions {
-		if c.Name == ageTableCol {
+		if strings.EqualFold(c.Name, ageTableCol) {
 			t.setAgeIndex(i)
 			continue
 		}
-		timeCol := ageCols.Has(c.Name)
+		timeCol := ageCols.Has(c.Name) || isTimestampCol(c)
 		h = append(h, model1.HeaderColumn{Name: strings.ToUpper(c.Name), Attrs: model1.Attrs{Time: timeCol}})
 	}
 	if t.getAgeIndex() > 0 {
@@ -138,13 +139,16 @@ func (t *Table) defaultRow(row *metav1.TableRow, ns string, r *model1.Row) error
 	)
 	for i, c := range row.Cells {
 		if ageIdx > 0 && i == ageIdx {
-			age = c
+			age = toTimestampAge(c)
 			continue
 		}
 		if c == nil {
 			r.Fields = append(r.Fields, Blank)
 			continue
 		}
+		if t.table != nil && i < len(t.table.ColumnDefinitions) && isTimestampCol(t.table.ColumnDefinitions[i]) {
+			c = toTimestampAge(c)
+		}
 		r.Fields = append(r.Fields, fmt.Sprintf("%v", c))
 	}
 	if d, ok := age.(string); ok {
@@ -156,3 +160,22 @@ func (t *Table) defaultRow(row *metav1.TableRow, ns string, r *model1.Row) error
 
 	return nil
 }
+
+// isTimestampCol checks if a column definition holds a timestamp.
+func isTimestampCol(c metav1.TableColumnDefinition) bool {
+	return c.Type == "date" || c.Format == "date-time"
+}
+
+// toTimestampAge converts RFC3339 timestamps to an age. Other values are left as is.
+func toTimestampAge(c any) any {
+	s, ok := c.(string)
+	if !ok {
+		return c
+	}
+	ts, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return c
+	}
+
+	return ToAge(metav1.NewTime(ts))
+}
@@ -2,6 +2,7 @@ package render
 
 import (
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/stretchr/testify/assert"
@@ -59,6 +60,21 @@ func Test_defaultHeader(t *testing.T) {
 				model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
 			},
 		},
+
+		"timestamp-cols": {
+			cdefs: []metav1.TableColumnDefinition{
+				{Name: "Fred"},
+				{Name: "Created", Type: "date"},
+				{Name: "Expires", Type: "string", Format: "date-time"},
+				{Name: "age"},
+			},
+			e: model1.Header{
+				model1.HeaderColumn{Name: "FRED"},
+				model1.HeaderColumn{Name: "CREATED", Attrs: model1.Attrs{Time: true}},
+				model1.HeaderColumn{Name: "EXPIRES", Attrs: model1.Attrs{Time: true}},
+				model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+			},
+		},
 	}
 
 	for k := range uu {
@@ -70,3 +86,31 @@ func Test_defaultHeader(t *testing.T) {
 		})
 	}
 }
+
+func Test_toTimestampAge(t *testing.T) {
+	uu := map[string]struct {
+		c any
+		e any
+	}{
+		"nil": {},
+		"duration": {
+			c: "2d",
+			e: "2d",
+		},
+		"timestamp": {
+			c: time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339),
+			e: "120m",
+		},
+		"number": {
+			c: int64(10),
+			e: int64(10),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, toTimestampAge(u.c))
+		})
+	}
+}
@@ -219,6 +219,30 @@ const (
 	// Duration tracks a duration logger key.
 	Duration = "duration"
 
+	// GracePeriod tracks a grace period logger key.
+	GracePeriod = "grace-period"
+
+	// Node tracks a node name logger key.
+	Node = "node"
+
+	// Op tracks an operation logger key.
+	Op = "op"
+
+	// RowsIn tracks an input rows count logger key.
+	RowsIn = "rows-in"
+
+	// RowsOut tracks an output rows count logger key.
+	RowsOut = "rows-out"
+
+	// FilterMode tracks a table filter mode logger key.
+	FilterMode = "filter-mode"
+
+	// SortCol tracks a table sort column logger key.
+	SortCol = "sort-col"
+
+	// Runtime tracks a container runtime logger key.
+	Runtime = "runtime"
+
 	// Type tracks a type logger key.
 	Type = "type"
 )
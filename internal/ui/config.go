@@ -29,11 +29,12 @@ type synchronizer interface {
 
 // Configurator represents an application configuration.
 type Configurator struct {
-	Config     *config.Config
-	Styles     *config.Styles
-	customView *config.CustomView
-	BenchFile  string
-	skinFile   string
+	Config        *config.Config
+	Styles        *config.Styles
+	customView    *config.CustomView
+	customFilters *config.CustomFilters
+	BenchFile     string
+	skinFile      string
 }
 
 func (c *Configurator) CustomView() *config.CustomView {
@@ -44,6 +45,21 @@ func (c *Configurator) CustomView() *config.CustomView {
 	return c.customView
 }
 
+// CustomFilters returns the named filters configuration.
+func (c *Configurator) CustomFilters() *config.CustomFilters {
+	if c.customFilters == nil {
+		c.customFilters = config.NewCustomFilters()
+		if err := c.customFilters.Load(config.AppFiltersFile); err != nil {
+			slog.Warn("Unable to load named filters",
+				slogs.FileName, config.AppFiltersFile,
+				slogs.Error, err,
+			)
+		}
+	}
+
+	return c.customFilters
+}
+
 // HasSkin returns true if a skin file was located.
 func (c *Configurator) HasSkin() bool {
 	return c.skinFile != ""
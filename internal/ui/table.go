@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strings"
 	"sync"
 
 	"github.com/derailed/k9s/internal"
@@ -23,6 +24,21 @@ import (
 
 const maxTruncate = 50
 
+// namedFilterPrefix flags a filter referencing a saved filter ie @name, or saving one ie @name=query.
+const namedFilterPrefix = "@"
+
+// ParseNamedFilter splits a named filter into its name and the query to save under it if any.
+// It returns false when the filter does not reference a named filter.
+func ParseNamedFilter(q string) (name, query string, ok bool) {
+	spec, ok := strings.CutPrefix(q, namedFilterPrefix)
+	if !ok {
+		return "", "", false
+	}
+	name, query, _ = strings.Cut(spec, "=")
+
+	return strings.TrimSpace(name), query, true
+}
+
 type (
 	// ColorerFunc represents a row colorer.
 	ColorerFunc func(ns string, evt model1.RowEvent) tcell.Color
@@ -56,6 +72,7 @@ type Table struct {
 	readOnly    bool
 	noIcon      bool
 	fullGVR     bool
+	filters     *config.CustomFilters
 }
 
 // NewTable returns a new table view.
@@ -214,6 +231,16 @@ func (t *Table) ResetToast() {
 	t.Refresh()
 }
 
+// IsToast checks if only toast resources are shown.
+func (t *Table) IsToast() bool {
+	return t.toast
+}
+
+// SetCustomFilters sets the saved filters named filters are resolved against.
+func (t *Table) SetCustomFilters(cf *config.CustomFilters) {
+	t.filters = cf
+}
+
 // ToggleToast toggles to show toast resources.
 func (t *Table) ToggleToast() {
 	t.toast = !t.toast
@@ -406,6 +433,9 @@ func (t *Table) buildRow(r int, re, ore model1.RowEvent, h model1.Header, pads M
 		cell.SetExpansion(1)
 		cell.SetAlign(h[c].Align)
 		fgColor := color(ns, h, &re)
+		if d, ok := re.Decoration(c); ok && d != model1.DecorationNone {
+			fgColor = d.Color()
+		}
 		cell.SetTextColor(fgColor)
 		if marked {
 			cell.SetTextColor(t.styles.Table().MarkColor.Color())
@@ -495,9 +525,23 @@ func (t *Table) AddHeaderCell(col int, h model1.HeaderColumn) {
 }
 
 func (t *Table) filtered(data *model1.TableData) *model1.TableData {
+	q := t.cmdBuff.GetText()
+	if name, query, ok := ParseNamedFilter(q); ok && t.filters != nil {
+		switch {
+		case query != "":
+			q = query
+		case name == "":
+			return data
+		default:
+			if td, err := data.FilterNamed(t.filters, name); err == nil {
+				return td
+			}
+		}
+	}
+
 	return data.Filter(model1.FilterOpts{
 		Toast:  t.toast,
-		Filter: t.cmdBuff.GetText(),
+		Filter: q,
 	})
 }
 
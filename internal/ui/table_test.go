@@ -90,6 +90,7 @@ func (*mockModel) Watch(context.Context) error                         { return
 func (*mockModel) Get(context.Context, string) (runtime.Object, error) { return nil, nil }
 func (*mockModel) InNamespace(string) bool                             { return true }
 func (*mockModel) SetRefreshRate(time.Duration)                        {}
+func (*mockModel) SetDeleteRetention(time.Duration)                    {}
 
 func (*mockModel) Delete(context.Context, string, *metav1.DeletionPropagation, dao.Grace) error {
 	return nil
@@ -134,3 +135,40 @@ func makeContext() context.Context {
 
 	return ctx
 }
+
+func TestParseNamedFilter(t *testing.T) {
+	uu := map[string]struct {
+		q           string
+		name, query string
+		ok          bool
+	}{
+		"plain": {
+			q: "fred",
+		},
+		"list": {
+			q:  "@",
+			ok: true,
+		},
+		"apply": {
+			q:    "@hot",
+			name: "hot",
+			ok:   true,
+		},
+		"save": {
+			q:     "@hot=-l app=fred",
+			name:  "hot",
+			query: "-l app=fred",
+			ok:    true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			name, query, ok := ui.ParseNamedFilter(u.q)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.name, name)
+			assert.Equal(t, u.query, query)
+		})
+	}
+}
@@ -74,6 +74,9 @@ type Tabular interface {
 	// SetRefreshRate sets the model watch loop rate.
 	SetRefreshRate(time.Duration)
 
+	// SetDeleteRetention sets how long deleted rows linger.
+	SetDeleteRetention(time.Duration)
+
 	// AddListener registers a model listener.
 	AddListener(model.TableListener)
 
@@ -198,12 +198,30 @@ func pluginAction(r Runner, p *config.Plugin) ui.ActionHandler {
 		}
 
 		cb := func() {
+			bin := p.Command
+			if bin == "kubectl" {
+				b, err := kubectlBin(r.App())
+				if errors.Is(err, ErrKubectlInCWD) {
+					r.App().Flash().Err(err)
+					return
+				}
+				if err == nil {
+					bin = b
+				}
+				args = kubectlPluginArgs(r.App(), args)
+			}
 			opts := shellOpts{
-				binary:     p.Command,
+				binary:     bin,
 				background: p.Background,
 				pipes:      p.Pipes,
 				args:       args,
 			}
+			if p.Pager {
+				if err := pageOutput(r.App(), &opts, p.Description); err != nil {
+					r.App().Flash().Err(err)
+				}
+				return
+			}
 			suspend, errChan, statusChan := run(r.App(), &opts)
 			if !suspend {
 				r.App().Flash().Infof("Plugin command failed: %q", p.Description)
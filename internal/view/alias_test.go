@@ -124,8 +124,9 @@ func (*mockModel) ToYAML(context.Context, string) (string, error) {
 	return "", nil
 }
 
-func (*mockModel) InNamespace(string) bool      { return true }
-func (*mockModel) SetRefreshRate(time.Duration) {}
+func (*mockModel) InNamespace(string) bool          { return true }
+func (*mockModel) SetRefreshRate(time.Duration)     {}
+func (*mockModel) SetDeleteRetention(time.Duration) {}
 
 func makeTableData() *model1.TableData {
 	return model1.NewTableDataWithRows(
@@ -12,8 +12,10 @@ import (
 	"os"
 	"os/signal"
 	"runtime"
+	"slices"
 	"sort"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"syscall"
 	"time"
@@ -51,10 +53,14 @@ type App struct {
 	command       *Command
 	factory       *watch.Factory
 	cancelFn      context.CancelFunc
+	rootCtx       context.Context
+	rootCancelFn  context.CancelFunc
 	clusterModel  *model.ClusterInfo
 	cmdHistory    *model.History
 	filterHistory *model.History
 	conRetry      int32
+	shellPods     shellPods
+	lastCmd       atomic.Pointer[string]
 	showHeader    bool
 	showLogo      bool
 	showCrumbs    bool
@@ -68,6 +74,7 @@ func NewApp(cfg *config.Config) *App {
 		filterHistory: model.NewHistory(model.MaxHistory),
 		Content:       NewPageStack(),
 	}
+	a.rootCtx, a.rootCancelFn = context.WithCancel(context.Background())
 	a.ReloadStyles()
 
 	a.Views()["statusIndicator"] = ui.NewStatusIndicator(a.App, a.Styles)
@@ -249,6 +256,7 @@ func (a *App) bindKeys() {
 		ui.KeyRightBracket: ui.NewSharedKeyAction("Go Forward", a.nextCommand, false),
 		ui.KeyDash:         ui.NewSharedKeyAction("Last View", a.lastCommand, false),
 		tcell.KeyCtrlA:     ui.NewSharedKeyAction("Aliases", a.aliasCmd, false),
+		tcell.KeyCtrlY:     ui.NewSharedKeyAction("Copy Last Command", a.copyLastCmd, false),
 		tcell.KeyEnter:     ui.NewKeyAction("Goto", a.gotoCmd, false),
 		tcell.KeyCtrlC:     ui.NewKeyAction("Quit", a.quitCmd, false),
 	}))
@@ -356,6 +364,15 @@ func (a *App) Resume() {
 	}
 }
 
+// rootContext returns the app context that is canceled on teardown.
+func (a *App) rootContext() context.Context {
+	if a.rootCtx == nil {
+		return context.Background()
+	}
+
+	return a.rootCtx
+}
+
 func (a *App) clusterUpdater(ctx context.Context) {
 	if err := a.refreshCluster(ctx); err != nil {
 		slog.Error("Cluster updater failed!", slogs.Error, err)
@@ -512,8 +529,13 @@ func (a *App) BailOut(exitCode int) {
 		}
 	}()
 
-	if err := nukeK9sShell(a); err != nil {
-		slog.Error("Unable to nuke k9s shell pod", slogs.Error, err)
+	for _, n := range a.shellPods.names() {
+		if err := nukeK9sShell(a, n); err != nil {
+			slog.Error("Unable to nuke k9s shell pod", slogs.Error, err)
+		}
+	}
+	if a.rootCancelFn != nil {
+		a.rootCancelFn()
 	}
 
 	a.stopImgScanner()
@@ -793,3 +815,85 @@ func (a *App) clusterInfo() *ClusterInfo {
 func (a *App) statusIndicator() *ui.StatusIndicator {
 	return a.Views()["statusIndicator"].(*ui.StatusIndicator)
 }
+
+func (a *App) shellPodName() string {
+	return a.shellPods.last()
+}
+
+func (a *App) setShellPodName(n string) {
+	a.shellPods.add(n)
+}
+
+// LastCommand returns the last command k9s ran with secrets redacted.
+func (a *App) LastCommand() string {
+	if c := a.lastCmd.Load(); c != nil {
+		return *c
+	}
+
+	return ""
+}
+
+func (a *App) setLastCommand(opts *shellOpts) {
+	c := opts.Redacted()
+	a.lastCmd.Store(&c)
+}
+
+func (a *App) copyLastCmd(evt *tcell.EventKey) *tcell.EventKey {
+	c := a.LastCommand()
+	if c == "" {
+		a.Flash().Warn("No command ran yet")
+		return nil
+	}
+	if err := clipboardWrite(c); err != nil {
+		a.Flash().Err(err)
+		return evt
+	}
+	a.Flash().Info("Last command copied to clipboard...")
+
+	return nil
+}
+
+func (a *App) clearShellPodName(n string) {
+	a.shellPods.remove(n)
+}
+
+// shellPods tracks the node shell pods launched by this session.
+type shellPods struct {
+	pods []string
+	mx   sync.RWMutex
+}
+
+func (s *shellPods) add(n string) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if !slices.Contains(s.pods, n) {
+		s.pods = append(s.pods, n)
+	}
+}
+
+func (s *shellPods) remove(n string) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.pods = slices.DeleteFunc(s.pods, func(p string) bool { return p == n })
+}
+
+// last returns the most recently launched shell pod if any.
+func (s *shellPods) last() string {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	if len(s.pods) == 0 {
+		return ""
+	}
+
+	return s.pods[len(s.pods)-1]
+}
+
+func (s *shellPods) names() []string {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	return slices.Clone(s.pods)
+}
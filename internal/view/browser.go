@@ -113,6 +113,7 @@ func (b *Browser) Init(ctx context.Context) error {
 		b.Select(1, 0)
 	}
 	b.GetModel().SetRefreshRate(time.Duration(b.App().Config.K9s.GetRefreshRate()) * time.Second)
+	b.GetModel().SetDeleteRetention(b.App().Config.K9s.GetDeleteRetention())
 
 	b.CmdBuff().SetSuggestionFn(b.suggestFilter())
 
@@ -74,6 +74,10 @@ func (d *Dir) bindDangerousKeys(aa *ui.KeyActions) {
 			Visible:   true,
 			Dangerous: true,
 		}),
+		ui.KeyShiftE: ui.NewKeyActionWithOpts("Edit & Apply", d.editApplyCmd, ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		}),
 	})
 }
 
@@ -120,6 +124,14 @@ func isManifest(s string) bool {
 }
 
 func (d *Dir) editCmd(evt *tcell.EventKey) *tcell.EventKey {
+	return d.editManifest(evt, false)
+}
+
+func (d *Dir) editApplyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	return d.editManifest(evt, true)
+}
+
+func (d *Dir) editManifest(evt *tcell.EventKey, postApply bool) *tcell.EventKey {
 	sel := d.GetTable().GetSelectedItem()
 	if sel == "" {
 		return evt
@@ -132,7 +144,7 @@ func (d *Dir) editCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 	d.Stop()
 	defer d.Start()
-	if !edit(d.App(), &shellOpts{clear: true, args: []string{sel}}) {
+	if !edit(d.App(), &shellOpts{clear: true, args: []string{sel}}, postApply) {
 		d.App().Flash().Errf("Failed to launch editor")
 	}
 
@@ -205,21 +217,10 @@ func (d *Dir) applyCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return evt
 	}
 
-	opts := []string{"-f"}
-	if containsDir(sel) {
-		opts = append(opts, "-R")
-	}
-	if isKustomized(sel) {
-		opts = []string{"-k"}
-	}
 	d.Stop()
 	defer d.Start()
 	{
-		args := make([]string, 0, 10)
-		args = append(args, "apply")
-		args = append(args, opts...)
-		args = append(args, sel)
-		res, err := runKu(d.App(), &shellOpts{clear: false, args: args})
+		res, err := runKu(d.App(), &shellOpts{clear: false, args: applyArgs(sel)})
 		if err != nil {
 			res = "status:\n  " + err.Error() + "\nmessage:\n" + fmtResults(res)
 		} else {
@@ -235,6 +236,22 @@ func (d *Dir) applyCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+// applyArgs returns the kubectl apply arguments for the given manifest or directory.
+func applyArgs(sel string) []string {
+	opts := []string{"-f"}
+	if containsDir(sel) {
+		opts = append(opts, "-R")
+	}
+	if isKustomized(sel) {
+		opts = []string{"-k"}
+	}
+	args := make([]string, 0, 10)
+	args = append(args, "apply")
+	args = append(args, opts...)
+
+	return append(args, sel)
+}
+
 func (d *Dir) delCmd(evt *tcell.EventKey) *tcell.EventKey {
 	sel := d.GetTable().GetSelectedItem()
 	if sel == "" {
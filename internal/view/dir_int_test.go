@@ -45,3 +45,20 @@ func TestIsKustomized(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyArgs(t *testing.T) {
+	uu := map[string]struct {
+		path string
+		e    []string
+	}{
+		"manifest":  {path: "testdata/fred.yaml", e: []string{"apply", "-f", "testdata/fred.yaml"}},
+		"kustomize": {path: "testdata/kmanifests", e: []string{"apply", "-k", "testdata/kmanifests"}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, applyArgs(u.path))
+		})
+	}
+}
@@ -4,26 +4,37 @@
 package view
 
 import (
+	"bufio"
 	"bytes"
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"io"
 	"log/slog"
+	"maps"
 	"os"
 	"os/exec"
 	"os/signal"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"syscall"
+	"text/template"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/fatih/color"
+	"github.com/google/shlex"
+	"golang.org/x/term"
 	v1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -31,35 +42,192 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/rand"
+	typedv1 "k8s.io/client-go/kubernetes/typed/core/v1"
 )
 
 const (
-	shellCheck   = `command -v bash >/dev/null && exec bash || exec sh`
-	bannerFmt    = "<<K9s-Shell>> Pod: %s | Container: %s \n"
-	outputPrefix = "[output]"
+	shellCheck         = `command -v bash >/dev/null && exec bash || exec sh`
+	readOnlyShellCheck = `command -v rbash >/dev/null && exec rbash || command -v bash >/dev/null && exec bash -r || { echo "no restricted shell (rbash or bash -r) found, refusing read-only shell" >&2; exit 1; }`
+	defaultBannerFmt   = "<<K9s-Shell>> Pod: {{.Pod}} | Container: {{.Container}}"
+	outputPrefix       = "[output]"
+	kubectlEnv         = "K9S_KUBECTL"
+	tmpDirEnv          = "TMPDIR"
 )
 
+// rootShellCheck elevates via sudo unless the container user is already root.
+const rootShellCheck = `[ "$(id -u)" = 0 ] || exec sudo -n sh -c '` + shellCheck + `'; ` + shellCheck
+
 var editorEnvVars = []string{"K9S_EDITOR", "KUBE_EDITOR", "EDITOR"}
 
+// lineToken represents the line number placeholder in an editor spec ie vim +{line}.
+const lineToken = "{line}"
+
+// editorArgs substitutes the line placeholder in the given editor arguments.
+// When no line is given, line tokens ie +{line} or :{line} are dropped.
+func editorArgs(tokens []string, line int) []string {
+	aa := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		if !strings.Contains(t, lineToken) {
+			aa = append(aa, t)
+			continue
+		}
+		if line > 0 {
+			aa = append(aa, strings.ReplaceAll(t, lineToken, strconv.Itoa(line)))
+			continue
+		}
+		t = strings.NewReplacer("+"+lineToken, "", ":"+lineToken, "", lineToken, "").Replace(t)
+		if t != "" {
+			aa = append(aa, t)
+		}
+	}
+
+	return aa
+}
+
+// errInterrupted signals a command was canceled by the user via a signal.
+var errInterrupted = errors.New("command interrupted")
+
+// execErrors tracks common command failures and their suggested fixes.
+var execErrors = []struct {
+	pattern, msg string
+}{
+	{"(Forbidden)", "Access denied. Check your RBAC permissions on this resource"},
+	{"(NotFound)", "Resource not found. It may have been deleted or live in another namespace"},
+	{"Unauthorized", "Authentication failed. Check your kubeconfig credentials or refresh your token"},
+	{"connection refused", "Unable to reach the API server. Check your cluster connection"},
+}
+
+// classifyExecError returns a friendly message for a known command failure or
+// an empty string otherwise.
+func classifyExecError(stderr string) string {
+	for _, e := range execErrors {
+		if strings.Contains(stderr, e.pattern) {
+			return e.msg
+		}
+	}
+
+	return ""
+}
+
+// execError carries a friendly message for a failed command while retaining its raw error.
+type execError struct {
+	msg string
+	err error
+}
+
+func (e *execError) Error() string {
+	return e.msg
+}
+
+func (e *execError) Unwrap() error {
+	return e.err
+}
+
+// classifyErr swaps a known command failure for a friendly one. The raw error is logged.
+func classifyErr(err error) error {
+	if err == nil {
+		return nil
+	}
+	msg := classifyExecError(err.Error())
+	if msg == "" {
+		return err
+	}
+	slog.Debug("Exec failed", slogs.Error, err)
+
+	return &execError{msg: msg, err: err}
+}
+
+// notifySignals relays interrupt and termination signals to the given channel.
+var notifySignals = func(c chan<- os.Signal) {
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+}
+
 type shellOpts struct {
 	clear, background bool
 	pipes             []string
 	binary            string
 	banner            string
 	args              []string
+	env               map[string]string
+	formatLine        func(string) string
+	// captureToPager captures the command output for display in k9s instead of the terminal.
+	captureToPager bool
+	// line is the file line an editor should open at, if any.
+	line int
+	// maxOutputBytes caps the captured output of one-shot commands.
+	maxOutputBytes int64
+	// retries tracks how many times a background command is retried on transient failures.
+	retries int
+	// backoff tracks the initial delay between retries. It doubles on each attempt.
+	backoff time.Duration
+	// onRetry is notified before a background command is retried.
+	onRetry func(attempt int, delay time.Duration)
 }
 
 func (s shellOpts) String() string {
 	return fmt.Sprintf("%s %s", s.binary, strings.Join(s.args, " "))
 }
 
-func runK(a *App, opts *shellOpts) error {
-	bin, err := exec.LookPath("kubectl")
+// sensitiveFlags tracks command flags whose values must not be disclosed.
+var sensitiveFlags = []string{"--token", "--password"}
+
+const redacted = "<redacted>"
+
+// Redacted returns the command line including pipes with sensitive flag values masked.
+func (s shellOpts) Redacted() string {
+	args := make([]string, 0, len(s.args))
+	for i, a := range s.args {
+		if i > 0 && slices.Contains(sensitiveFlags, s.args[i-1]) {
+			args = append(args, redacted)
+			continue
+		}
+		if f, _, ok := strings.Cut(a, "="); ok && slices.Contains(sensitiveFlags, f) {
+			args = append(args, f+"="+redacted)
+			continue
+		}
+		args = append(args, a)
+	}
+	cmd := strings.Join(append([]string{s.binary}, args...), " ")
+	for _, p := range s.pipes {
+		cmd += " | " + p
+	}
+
+	return cmd
+}
+
+// ErrKubectlInCWD indicates the kubectl binary resolved to the current working directory.
+var ErrKubectlInCWD = errors.New("kubectl must not reside in the current working directory. Move it out of the current directory or set the k9s kubectlBinary config")
+
+// kubectlBin resolves the kubectl binary, honoring the configured binary if any.
+func kubectlBin(a *App) (string, error) {
+	return kubectlPath(a.Config.K9s.KubectlBinary)
+}
+
+// kubectlPath resolves the kubectl binary from K9S_KUBECTL, then the given
+// configured binary and lastly kubectl in the user's path.
+func kubectlPath(cfgBin string) (string, error) {
+	bin := "kubectl"
+	if b := os.Getenv(kubectlEnv); b != "" {
+		bin = b
+	} else if cfgBin != "" {
+		bin = cfgBin
+	}
+	path, err := exec.LookPath(bin)
 	if errors.Is(err, exec.ErrDot) {
-		return fmt.Errorf("kubectl command must not be in the current working directory: %w", err)
+		return "", fmt.Errorf("%w: %w", ErrKubectlInCWD, err)
 	}
 	if err != nil {
-		return fmt.Errorf("kubectl command is not in your path: %w", err)
+		return "", fmt.Errorf("kubectl command is not in your path: %w", err)
+	}
+
+	return path, nil
+}
+
+func runK(a *App, opts *shellOpts) error {
+	bin, err := kubectlBin(a)
+	if err != nil {
+		return err
 	}
 	args := []string{opts.args[0]}
 	if u, err := a.Conn().Config().ImpersonateUser(); err == nil {
@@ -79,6 +247,16 @@ func runK(a *App, opts *shellOpts) error {
 		opts.args = append(args, opts.args[1:]...)
 	}
 	opts.binary = bin
+	if opts.env == nil {
+		opts.env = a.Config.K9s.ExecEnv()
+	}
+	opts.env = execTempDir(a, opts.env)
+	if opts.background && opts.retries == 0 {
+		opts.retries, opts.backoff = a.Config.K9s.ExecRetry()
+	}
+	if opts.captureToPager {
+		return pageOutput(a, opts, "Output")
+	}
 
 	suspended, errChan, stChan := run(a, opts)
 	if !suspended {
@@ -92,17 +270,23 @@ func runK(a *App, opts *shellOpts) error {
 		errs = errors.Join(errs, e)
 	}
 
-	return errs
+	return classifyErr(errs)
 }
 
 func run(a *App, opts *shellOpts) (ok bool, errC chan error, outC chan string) {
 	errChan := make(chan error, 1)
 	statusChan := make(chan string, 1)
+	a.setLastCommand(opts)
 
 	if opts.background {
-		if err := execute(opts, statusChan); err != nil {
+		if opts.retries > 0 && opts.onRetry == nil {
+			opts.onRetry = func(attempt int, delay time.Duration) {
+				a.Flash().Warnf("Command %q failed. Retrying in %s (%d/%d)...", opts.Redacted(), delay, attempt, opts.retries)
+			}
+		}
+		if err := execute(a.rootContext(), opts, statusChan); err != nil {
 			errChan <- err
-			a.Flash().Errf("Exec failed %q: %s", opts, err)
+			a.Flash().Errf("Exec failed %q: %s", opts, classifyErr(err))
 		}
 		close(errChan)
 		return true, errChan, statusChan
@@ -112,19 +296,25 @@ func run(a *App, opts *shellOpts) (ok bool, errC chan error, outC chan string) {
 	defer a.Resume()
 
 	return a.Suspend(func() {
-		if err := execute(opts, statusChan); err != nil {
+		if err := execute(a.rootContext(), opts, statusChan); err != nil {
 			errChan <- err
-			a.Flash().Errf("Exec failed %q: %s", opts, err)
+			a.Flash().Errf("Exec failed %q: %s", opts, classifyErr(err))
 		}
 		close(errChan)
 	}), errChan, statusChan
 }
 
-func edit(a *App, opts *shellOpts) bool {
+// edit launches the user's editor on the given file. When postApply is set, the
+// edited file is applied via kubectl once the editor exits successfully.
+func edit(a *App, opts *shellOpts, postApply bool) bool {
 	var (
-		bin string
-		err error
+		bin  string
+		err  error
+		file string
 	)
+	if len(opts.args) > 0 {
+		file = opts.args[len(opts.args)-1]
+	}
 	for _, e := range editorEnvVars {
 		env := os.Getenv(e)
 		if env == "" {
@@ -142,7 +332,7 @@ func edit(a *App, opts *shellOpts) bool {
 			// with custom options)
 			if len(envTokens) > 1 {
 				originalArgs := opts.args
-				opts.args = envTokens[1:]
+				opts.args = editorArgs(envTokens[1:], opts.line)
 				opts.args = append(opts.args, originalArgs...)
 			}
 
@@ -154,6 +344,7 @@ func edit(a *App, opts *shellOpts) bool {
 		return false
 	}
 	opts.binary, opts.background = bin, false
+	opts.env = execTempDir(a, opts.env)
 
 	suspended, errChan, _ := run(a, opts)
 	if !suspended {
@@ -164,35 +355,111 @@ func edit(a *App, opts *shellOpts) bool {
 		a.Flash().Err(e)
 		status = false
 	}
+	if status && suspended && postApply && file != "" {
+		applyEdit(a, file)
+	}
 
 	return status
 }
 
-func execute(opts *shellOpts, statusChan chan<- string) error {
+// applyEdit applies the given edited manifest and flashes the outcome.
+func applyEdit(a *App, file string) {
+	res, err := runKu(a, &shellOpts{args: applyArgs(file)})
+	if err != nil {
+		a.Flash().Errf("Apply %s failed: %s", file, err)
+		return
+	}
+	a.Flash().Infof("Applied %s: %s", file, strings.TrimSpace(res))
+}
+
+func execute(ctx context.Context, opts *shellOpts, statusChan chan<- string) error {
 	if opts.clear {
 		clearScreen()
 	}
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancelCause(ctx)
 	defer func() {
 		if !opts.background {
-			cancel()
-			clearScreen()
+			// Keep the terminal output around when the user bailed out via a signal.
+			interrupted := errors.Is(context.Cause(ctx), errInterrupted)
+			cancel(nil)
+			if !interrupted {
+				clearScreen()
+			}
 		}
 	}()
 
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
-	go func(cancel context.CancelFunc) {
+	sigChan, done := make(chan os.Signal, 1), make(chan struct{})
+	notifySignals(sigChan)
+	defer func() {
+		signal.Stop(sigChan)
+		close(done)
+	}()
+	go func(cancel context.CancelCauseFunc) {
 		defer slog.Debug("Got signal canceled")
 		select {
 		case sig := <-sigChan:
 			slog.Debug("Command canceled with signal", slogs.Sig, sig)
-			cancel()
+			cancel(errInterrupted)
 		case <-ctx.Done():
 			slog.Debug("Signal context canceled!")
+		case <-done:
 		}
 	}(cancel)
 
+	cmds := buildCmds(ctx, opts)
+
+	var o, e bytes.Buffer
+	err := pipe(ctx, opts, statusChan, &o, &e, cmds...)
+	if err != nil {
+		slog.Error("Exec failed",
+			slogs.Error, err,
+			slogs.Command, cmds,
+		)
+		return errors.Join(err, fmt.Errorf("%s", e.String()))
+	}
+
+	return nil
+}
+
+// pipeArgs tokenizes a pipe stage honoring shell quoting ie grep "foo bar".
+// Stages that fail to tokenize fall back to a plain space split.
+func pipeArgs(p string) []string {
+	tokens, err := shlex.Split(p)
+	if err != nil {
+		slog.Warn("Unable to tokenize pipe. Falling back to space split", slogs.Command, p, slogs.Error, err)
+		return strings.Fields(p)
+	}
+
+	return tokens
+}
+
+// limitedWriter writes at most n bytes and silently drops the rest so the
+// command does not fail on a broken pipe.
+type limitedWriter struct {
+	w         io.Writer
+	n         int64
+	truncated bool
+}
+
+// Write writes to the underlying writer until its limit is reached.
+func (l *limitedWriter) Write(p []byte) (int, error) {
+	size := len(p)
+	if int64(size) > l.n {
+		p, l.truncated = p[:l.n], true
+	}
+	if len(p) > 0 {
+		n, err := l.w.Write(p)
+		l.n -= int64(n)
+		if err != nil {
+			return n, err
+		}
+	}
+
+	return size, nil
+}
+
+// buildCmds returns the commands to run for the given options, the main command first followed by its pipes.
+func buildCmds(ctx context.Context, opts *shellOpts) []*exec.Cmd {
 	cmds := make([]*exec.Cmd, 0, 1)
 	cmd := exec.CommandContext(ctx, opts.binary, opts.args...)
 	slog.Debug("Exec command", slogs.Command, opts)
@@ -205,46 +472,158 @@ func execute(opts *shellOpts, statusChan chan<- string) error {
 		binTokens := strings.Split(env, " ")
 
 		if bin, err := exec.LookPath(binTokens[0]); err == nil {
-			binTokens[0] = bin
+			binTokens = append([]string{bin}, editorArgs(binTokens[1:], 0)...)
 			cmd.Env = append(os.Environ(), fmt.Sprintf("KUBE_EDITOR=%s", strings.Join(binTokens, " ")))
 		}
 	}
+	env := opts.env
+	if !opts.background && !opts.captureToPager {
+		env = withTTYSize(env)
+	}
+	if len(env) > 0 {
+		cmd.Env = mergeEnv(cmd.Env, env)
+	}
 
 	cmds = append(cmds, cmd)
 
 	for _, p := range opts.pipes {
-		tokens := strings.Split(p, " ")
-		if len(tokens) < 2 {
+		tokens := pipeArgs(p)
+		if len(tokens) == 0 {
 			continue
 		}
 		cmd := exec.CommandContext(ctx, tokens[0], tokens[1:]...)
+		if len(opts.env) > 0 {
+			cmd.Env = mergeEnv(nil, opts.env)
+		}
 		slog.Debug("Exec command", slogs.Command, cmd)
 		cmds = append(cmds, cmd)
 	}
 
+	return cmds
+}
+
+// errInteractive signals a command requiring a terminal can not be captured.
+var errInteractive = errors.New("interactive commands can not be displayed in a pager")
+
+// interactiveCmds tracks kubectl commands requiring a terminal.
+var interactiveCmds = []string{"exec", "attach", "edit", "debug", "run"}
+
+// isInteractive checks if the given command arguments require a terminal.
+func isInteractive(args []string) bool {
+	var sub string
+	for i, a := range args {
+		switch a {
+		case "-i", "-t", "-it", "-ti", "--stdin", "--tty":
+			return true
+		}
+		if sub == "" && !strings.HasPrefix(a, "-") && (i == 0 || !strings.HasPrefix(args[i-1], "--")) {
+			sub = a
+		}
+	}
+
+	return slices.Contains(interactiveCmds, sub)
+}
+
+// capture runs a non interactive command and returns its output.
+func capture(ctx context.Context, opts *shellOpts) (string, error) {
+	if isInteractive(opts.args) {
+		return "", errInteractive
+	}
+	opts.captureToPager, opts.background = true, false
+
 	var o, e bytes.Buffer
-	err := pipe(ctx, opts, statusChan, &o, &e, cmds...)
-	if err != nil {
+	cmds := buildCmds(ctx, opts)
+	if err := pipe(ctx, opts, make(chan string, 1), &o, &e, cmds...); err != nil {
 		slog.Error("Exec failed",
 			slogs.Error, err,
 			slogs.Command, cmds,
 		)
-		return errors.Join(err, fmt.Errorf("%s", e.String()))
+		return o.String(), errors.Join(err, fmt.Errorf("%s", e.String()))
 	}
 
+	return o.String(), nil
+}
+
+// pageOutputTimeout bounds commands whose output is shown in the pager.
+const pageOutputTimeout = 30 * time.Second
+
+// pageOutput runs a non interactive command in the background and shows its output
+// in a details view without suspending the app.
+func pageOutput(a *App, opts *shellOpts, title string) error {
+	if isInteractive(opts.args) {
+		return errInteractive
+	}
+	a.setLastCommand(opts)
+	a.Flash().Infof("Running %s...", title)
+	go func() {
+		ctx, cancel := context.WithTimeout(a.rootContext(), pageOutputTimeout)
+		defer cancel()
+
+		out, err := capture(ctx, opts)
+		a.QueueUpdateDraw(func() {
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				a.Flash().Errf("%s timed out after %s", title, pageOutputTimeout)
+				return
+			}
+			if err != nil {
+				a.Flash().Err(err)
+				return
+			}
+			a.Flash().Clear()
+			details := NewDetails(a, title, opts.String(), contentTXT, true).Update(out)
+			if err := a.inject(details, false); err != nil {
+				a.Flash().Err(err)
+			}
+		})
+	}()
+
 	return nil
 }
 
 func runKu(a *App, opts *shellOpts) (string, error) {
-	bin, err := exec.LookPath("kubectl")
-	if errors.Is(err, exec.ErrDot) {
-		slog.Error("Kubectl exec can not reside in current working directory", slogs.Error, err)
+	if err := kubectlOpts(a, opts); err != nil {
 		return "", err
 	}
+
+	return oneShoot(opts)
+}
+
+// runKuStream runs a kubectl command and hands its output lines to fn as they
+// arrive ie for watches or large outputs. The command is killed once the
+// context is canceled.
+func runKuStream(ctx context.Context, a *App, opts *shellOpts, fn func(line string)) error {
+	if err := kubectlOpts(a, opts); err != nil {
+		return err
+	}
+
+	return streamShoot(ctx, opts, fn)
+}
+
+// kubectlOpts prepares the given options to run kubectl against the active context.
+func kubectlOpts(a *App, opts *shellOpts) error {
+	bin, err := kubectlBin(a)
 	if err != nil {
-		slog.Error("Kubectl exec not found", slogs.Error, err)
-		return "", err
+		slog.Error("Kubectl exec lookup failed", slogs.Error, err)
+		return err
+	}
+	if args := kubectlFlags(a); len(args) > 0 {
+		opts.args = append(args, opts.args...)
+	}
+	opts.binary, opts.background = bin, false
+	if opts.env == nil {
+		opts.env = a.Config.K9s.ExecEnv()
+	}
+	opts.env = execTempDir(a, opts.env)
+	if opts.maxOutputBytes == 0 {
+		opts.maxOutputBytes = a.Config.K9s.ExecMaxOutputBytes()
 	}
+	a.setLastCommand(opts)
+
+	return nil
+}
+
+// kubectlFlags returns the kubectl impersonation and connection flags for the active context.
+func kubectlFlags(a *App) []string {
 	var args []string
 	if u, err := a.Conn().Config().ImpersonateUser(); err == nil {
 		args = append(args, "--as", u)
@@ -256,12 +635,66 @@ func runKu(a *App, opts *shellOpts) (string, error) {
 	if cfg := a.Conn().Config().Flags().KubeConfig; cfg != nil && *cfg != "" {
 		args = append(args, "--kubeconfig", *cfg)
 	}
-	if len(args) > 0 {
-		opts.args = append(args, opts.args...)
+
+	return args
+}
+
+// kubectlPluginArgs treats the first arg as a kubectl plugin ie kubectl neat and
+// injects the active context connection flags when the plugin is declared as
+// supporting them. Other plugins are left alone so unsupported flags do not break them.
+func kubectlPluginArgs(a *App, args []string) []string {
+	if len(args) == 0 || !a.Config.K9s.PluginAcceptsGlobalFlags(args[0]) {
+		return args
 	}
-	opts.binary, opts.background = bin, false
 
-	return oneShoot(opts)
+	return pluginArgs(args[0], args[1:], kubectlFlags(a))
+}
+
+// pluginArgs builds a kubectl plugin command line. Kubectl only dispatches to
+// plugins named first so the flags are passed after the plugin name.
+func pluginArgs(plugin string, args, flags []string) []string {
+	aa := make([]string, 0, 1+len(flags)+len(args))
+	aa = append(aa, plugin)
+	aa = append(aa, flags...)
+
+	return append(aa, args...)
+}
+
+// kubectlDescribe returns kubectl describe output for the given resource.
+func kubectlDescribe(a *App, gvr *client.GVR, fqn string) (string, error) {
+	return runKu(a, &shellOpts{args: describeArgs(gvr, fqn)})
+}
+
+func describeArgs(gvr *client.GVR, fqn string) []string {
+	args := make([]string, 0, 5)
+	args = append(args, "describe")
+	ns, n := client.Namespaced(fqn)
+	if ns != client.BlankNamespace {
+		args = append(args, "-n", ns)
+	}
+
+	return append(args, gvr.AsResourceName(), n)
+}
+
+// mergeEnv merges the given env vars onto the base environment or the current one if none.
+// On key collisions, the given env vars win.
+func mergeEnv(base []string, env map[string]string) []string {
+	if base == nil {
+		base = os.Environ()
+	}
+	ee := make([]string, 0, len(base)+len(env))
+	for _, e := range base {
+		k, _, _ := strings.Cut(e, "=")
+		if _, ok := env[k]; !ok {
+			ee = append(ee, e)
+		}
+	}
+	kk := slices.Sorted(maps.Keys(env))
+	for _, k := range kk {
+		ee = append(ee, k+"="+env[k])
+	}
+
+	return ee
 }
 
 func oneShoot(opts *shellOpts) (string, error) {
@@ -274,20 +707,325 @@ func oneShoot(opts *shellOpts) (string, error) {
 		slogs.Args, strings.Join(opts.args, " "),
 	)
 	cmd := exec.Command(opts.binary, opts.args...)
+	if len(opts.env) > 0 {
+		cmd.Env = mergeEnv(nil, opts.env)
+	}
+
+	limit := opts.maxOutputBytes
+	if limit <= 0 {
+		limit = config.DefaultMaxOutputBytes
+	}
+	var out, errOut bytes.Buffer
+	lw := limitedWriter{w: &out, n: limit}
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, &lw, &limitedWriter{w: &errOut, n: limit}
+	if opts.banner != "" {
+		_, _ = lw.Write([]byte(opts.banner))
+	}
+	err := cmd.Run()
+	res, stderr := strings.Trim(out.String(), "\n"), strings.TrimSpace(errOut.String())
+	if lw.truncated {
+		slog.Warn("Command output truncated",
+			slogs.Bin, opts.binary,
+			slogs.Max, limit,
+		)
+		res += fmt.Sprintf("\n... output truncated at %d bytes", limit)
+	}
+	if err != nil {
+		if stderr != "" {
+			return res, fmt.Errorf("%w: %s", err, stderr)
+		}
+		return res, err
+	}
+	if stderr != "" {
+		slog.Warn("Command reported on stderr",
+			slogs.Bin, opts.binary,
+			slogs.Message, stderr,
+		)
+	}
+
+	return res, nil
+}
+
+const (
+	// maxStreamLineBytes caps the size of a single streamed output line.
+	maxStreamLineBytes = 1 << 20
+	// streamWaitDelay tracks how long to wait on output once a streamed command exits.
+	streamWaitDelay = time.Second
+)
+
+// streamShoot runs a command and hands each stdout line to fn as it arrives.
+// Canceling the context kills the command and returns the context error.
+func streamShoot(ctx context.Context, opts *shellOpts, fn func(line string)) error {
+	slog.Debug("Streaming command",
+		slogs.Bin, opts.binary,
+		slogs.Args, strings.Join(opts.args, " "),
+	)
+	cctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	cmd := exec.CommandContext(cctx, opts.binary, opts.args...)
+	if len(opts.env) > 0 {
+		cmd.Env = mergeEnv(nil, opts.env)
+	}
+	limit := opts.maxOutputBytes
+	if limit <= 0 {
+		limit = config.DefaultMaxOutputBytes
+	}
+	var errOut bytes.Buffer
+	pr, pw := io.Pipe()
+	cmd.Stdout, cmd.Stderr = pw, &limitedWriter{w: &errOut, n: limit}
+	cmd.WaitDelay = streamWaitDelay
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	errc := make(chan error, 1)
+	go func() {
+		err := cmd.Wait()
+		_ = pw.Close()
+		errc <- err
+	}()
 
-	var err error
-	buff := bytes.NewBufferString("")
-	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, buff, buff
-	_, _ = cmd.Stdout.Write([]byte(opts.banner))
-	err = cmd.Run()
+	sc := bufio.NewScanner(pr)
+	sc.Buffer(nil, maxStreamLineBytes)
+	for sc.Scan() {
+		fn(sc.Text())
+	}
+	// Bail out on unreadable output rather than draining a never ending stream.
+	scanErr := sc.Err()
+	if scanErr != nil {
+		cancel()
+	}
+	_, _ = io.Copy(io.Discard, pr)
+	err := <-errc
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if scanErr != nil {
+		return scanErr
+	}
+	if err != nil {
+		if stderr := strings.TrimSpace(errOut.String()); stderr != "" {
+			return fmt.Errorf("%w: %s", err, stderr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// isTerminal checks if the given file descriptor is a terminal.
+var isTerminal = func(fd uintptr) bool {
+	return term.IsTerminal(int(fd))
+}
+
+// termSize returns the given terminal file descriptor width and height.
+var termSize = func(fd uintptr) (int, int, error) {
+	return term.GetSize(int(fd))
+}
+
+// withTTYSize returns the given env vars with LINES and COLUMNS set from the
+// current terminal size if available. Explicit env vars win.
+func withTTYSize(env map[string]string) map[string]string {
+	w, h, err := termSize(os.Stdout.Fd())
+	if err != nil || w <= 0 || h <= 0 {
+		return env
+	}
+	ee := make(map[string]string, len(env)+2)
+	ee["COLUMNS"], ee["LINES"] = strconv.Itoa(w), strconv.Itoa(h)
+	maps.Copy(ee, env)
+
+	return ee
+}
+
+// withTempDir points the command scratch files ie kubectl edit manifests to the given dir.
+// A TMPDIR set in the given env takes precedence.
+func withTempDir(env map[string]string, dir string) map[string]string {
+	if dir == "" || dir == os.TempDir() {
+		return env
+	}
+	ee := make(map[string]string, len(env)+1)
+	ee[tmpDirEnv] = dir
+	maps.Copy(ee, env)
 
-	return strings.Trim(buff.String(), "\n"), err
+	return ee
 }
 
-func clearScreen() {
+// execTempDir returns the env for the given command with the configured temp dir set.
+func execTempDir(a *App, env map[string]string) map[string]string {
+	dir, err := a.Config.K9s.ExecTempDir()
+	if err != nil {
+		slog.Warn("Exec temp dir unavailable", slogs.Dir, dir, slogs.Error, err)
+		return env
+	}
+
+	return withTempDir(env, dir)
+}
+
+// noColor checks if colors are disabled via NO_COLOR or a dumb terminal.
+func noColor() bool {
+	return os.Getenv("NO_COLOR") != "" || os.Getenv("TERM") == "dumb"
+}
+
+// ansiEnabled checks if ANSI escapes can be written to stdout.
+func ansiEnabled() bool {
+	return !noColor() && isTerminal(os.Stdout.Fd())
+}
+
+// clearScreen clears the terminal when ANSI escapes are supported.
+var clearScreen = func() {
+	if !ansiEnabled() {
+		return
+	}
 	fmt.Print("\033[H\033[2J")
 }
 
+// banner tracks the shell banner settings.
+type banner struct {
+	disabled bool
+	// format tracks the banner template. Defaults to defaultBannerFmt.
+	format string
+	fg, bg config.Color
+}
+
+// execBanner returns the shell banner settings from the k9s config and active skin.
+func execBanner(a *App) banner {
+	f, ok := a.Config.K9s.ExecBanner()
+	b := banner{disabled: !ok, format: f}
+	if a.Styles != nil {
+		s := a.Styles.Shell()
+		b.fg, b.bg = s.FgColor, s.BgColor
+	}
+
+	return b
+}
+
+// render renders the banner template for the given pod and container. The mode
+// ie READ-ONLY is appended if set.
+func (b banner) render(path, co, mode string) string {
+	if b.disabled {
+		return ""
+	}
+	var sb strings.Builder
+	if err := renderBanner(&sb, cmp.Or(b.format, defaultBannerFmt), path, co); err != nil {
+		slog.Warn("Invalid shell banner format. Using default", slogs.Error, err)
+		sb.Reset()
+		_ = renderBanner(&sb, defaultBannerFmt, path, co)
+	}
+	if mode != "" {
+		sb.WriteString(" | " + mode)
+	}
+	sb.WriteString(" \n")
+
+	return sb.String()
+}
+
+func renderBanner(w io.Writer, format, path, co string) error {
+	tpl, err := template.New("banner").Parse(format)
+	if err != nil {
+		return err
+	}
+
+	return tpl.Execute(w, struct{ Pod, Container string }{Pod: path, Container: co})
+}
+
+// style returns the banner colors, falling back to black on green.
+func (b banner) style() *color.Color {
+	c := color.New(color.Bold)
+	if r, g, bl, ok := bannerRGB(b.fg); ok {
+		c.AddRGB(r, g, bl)
+	} else if b.fg == "" {
+		c.Add(color.FgBlack)
+	}
+	if r, g, bl, ok := bannerRGB(b.bg); ok {
+		c.AddBgRGB(r, g, bl)
+	} else if b.bg == "" {
+		c.Add(color.BgGreen)
+	}
+
+	return c
+}
+
+func bannerRGB(c config.Color) (r, g, b int, ok bool) {
+	if c == "" || c == config.DefaultColor {
+		return 0, 0, 0, false
+	}
+	r32, g32, b32 := c.Color().RGB()
+	if r32 < 0 {
+		return 0, 0, 0, false
+	}
+
+	return int(r32), int(g32), int(b32), true
+}
+
+// shellBanner returns the shell banner, in plain text when colors are disabled.
+// Read-only banners keep their warning colors regardless of the skin.
+func shellBanner(b banner, path, co string, readOnly bool) string {
+	mode, c := "", b.style()
+	if readOnly {
+		mode, c = "READ-ONLY", color.New(color.BgYellow).Add(color.FgBlack).Add(color.Bold)
+	}
+	s := b.render(path, co, mode)
+	if s == "" || noColor() {
+		return s
+	}
+
+	return c.Sprint(s)
+}
+
+// rootShellBanner returns a banner flagging a root shell session.
+func rootShellBanner(b banner, path, co string) string {
+	s := b.render(path, co, "ROOT")
+	if s == "" || noColor() {
+		return s
+	}
+
+	return color.New(color.BgRed).Add(color.FgWhite).Add(color.Bold).Sprint(s)
+}
+
+// readOnlyNodeShell checks if node shells must be read-only either via the shell pod
+// config or the active context feature gates.
+func readOnlyNodeShell(a *App) bool {
+	if cfg := a.Config.K9s.ShellPod; cfg != nil && cfg.ReadOnly {
+		return true
+	}
+	ct, err := a.Config.K9s.ActiveContext()
+
+	return err == nil && ct.FeatureGates.ReadOnlyNodeShell
+}
+
+// shellProbe returns the command detecting the node shell to run.
+func shellProbe(a *App) string {
+	ct, err := a.Config.K9s.ActiveContext()
+	if err != nil {
+		ct = nil
+	}
+
+	return resolveShellCheck(ct, a.Config.K9s.ShellPod)
+}
+
+// resolveShellCheck returns the configured shell detection command. The context
+// setting wins over the shell pod one. Shells are run as login shells if so configured.
+func resolveShellCheck(ct *data.Context, cfg *config.ShellPod) string {
+	check := shellCheck
+	switch {
+	case ct != nil && ct.ShellCheck != "":
+		check = ct.ShellCheck
+	case cfg != nil && cfg.ShellCheck != "":
+		check = cfg.ShellCheck
+	}
+	if cfg != nil && cfg.LoginShell {
+		return loginShell(check)
+	}
+
+	return check
+}
+
+var execShellRx = regexp.MustCompile(`\bexec (\S+)(?: -l\b)?`)
+
+// loginShell adds the login flag to the shells exec'ed by the given shell check.
+func loginShell(check string) string {
+	return execShellRx.ReplaceAllString(check, "exec $1 -l")
+}
+
 const (
 	k9sShell           = "k9s-shell"
 	k9sShellRetryCount = 50
@@ -295,53 +1033,96 @@ const (
 )
 
 func launchNodeShell(v model.Igniter, a *App, node string) {
-	if err := nukeK9sShell(a); err != nil {
-		a.Flash().Errf("Cleaning node shell failed: %s", err)
-		return
+	concurrent := a.Config.K9s.ShellPod != nil && a.Config.K9s.ShellPod.AllowConcurrentShells
+	if !concurrent {
+		if err := nukeK9sShell(a, a.shellPodName()); err != nil {
+			a.Flash().Errf("Cleaning node shell failed: %s", err)
+			return
+		}
 	}
 
 	msg := fmt.Sprintf("Launching node shell on %s...", node)
+	if node == "" {
+		msg = "Launching node shell on a scheduled node..."
+	}
 	d := a.Styles.Dialog()
 	dialog.ShowPrompt(&d, a.Content.Pages, "Launching", msg, func(ctx context.Context) {
-		err := launchShellPod(ctx, a, node)
+		name, err := launchShellPod(ctx, a, node)
+		if name != "" {
+			a.setShellPodName(name)
+		}
 		if err != nil {
 			if !errors.Is(err, context.Canceled) {
 				a.Flash().Errf("Launching node shell failed: %s", err)
 			}
+			if err := nukeK9sShell(a, name); err != nil {
+				a.Flash().Errf("Cleaning node shell failed: %s", err)
+			}
 			return
 		}
 
-		go launchPodShell(v, a)
+		go launchPodShell(v, a, name)
 	}, func() {
-		if err := nukeK9sShell(a); err != nil {
+		if concurrent {
+			return
+		}
+		if err := nukeK9sShell(a, a.shellPodName()); err != nil {
 			a.Flash().Errf("Cleaning node shell failed: %s", err)
 			return
 		}
 	})
 }
 
-func launchPodShell(v model.Igniter, a *App) {
+func launchPodShell(v model.Igniter, a *App, name string) {
 	if a.Config.K9s.ShellPod == nil {
 		slog.Error("Shell pod not configured!")
 		return
 	}
 
 	defer func() {
-		if err := nukeK9sShell(a); err != nil {
+		if err := nukeK9sShell(a, name); err != nil {
 			a.Flash().Errf("Launching node shell failed: %s", err)
 			return
 		}
 	}()
 
+	if d := a.Config.K9s.ShellPod.MaxLifetime.Duration; d > 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go shellPodWatchdog(ctx, d, func() {
+			slog.Warn("Node shell max lifetime reached. Deleting shell pod",
+				slogs.Name, name,
+				slogs.Duration, d,
+			)
+			if err := nukeK9sShell(a, name); err != nil {
+				a.Flash().Errf("Cleaning node shell failed: %s", err)
+				return
+			}
+			a.Flash().Warnf("Node shell %s exceeded its max lifetime (%s) and was deleted", name, d)
+		})
+	}
+
 	v.Stop()
 	defer v.Start()
 
 	ns := a.Config.K9s.ShellPod.Namespace
-	if err := sshIn(a, client.FQN(ns, k9sShellPodName()), k9sShell); err != nil {
+	if err := sshIn(a, client.FQN(ns, name), k9sShell); err != nil {
 		a.Flash().Errf("Launching node shell failed: %s", err)
 	}
 }
 
+// shellPodWatchdog calls nuke once the given lifetime elapses unless the context is canceled first.
+func shellPodWatchdog(ctx context.Context, d time.Duration, nuke func()) {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+	case <-t.C:
+		nuke()
+	}
+}
+
 func sshIn(a *App, fqn, co string) error {
 	cfg := a.Config.K9s.ShellPod
 	platform, err := getPodOS(a.factory, fqn)
@@ -349,23 +1130,27 @@ func sshIn(a *App, fqn, co string) error {
 		return fmt.Errorf("os detect failed: %w", err)
 	}
 
+	ro := readOnlyNodeShell(a)
 	args := buildShellArgs("exec", fqn, co, a.Conn().Config().Flags())
 	args = append(args, "--")
-	if len(cfg.Command) > 0 {
+	// The read-only gate wins over any custom command so it cannot be bypassed.
+	switch {
+	case ro:
+		args = append(args, "sh", "-c", readOnlyShellCheck)
+	case len(cfg.Command) > 0:
 		args = append(args, cfg.Command...)
 		args = append(args, cfg.Args...)
-	} else {
+	default:
 		if platform == windowsOS {
 			args = append(args, "--", powerShell)
 		}
-		args = append(args, "sh", "-c", shellCheck)
+		args = append(args, "sh", "-c", shellProbe(a))
 	}
 	slog.Debug("Running command with args", slogs.Args, args)
 
-	c := color.New(color.BgGreen).Add(color.FgBlack).Add(color.Bold)
 	err = runK(a, &shellOpts{
 		clear:  true,
-		banner: c.Sprintf(bannerFmt, fqn, co),
+		banner: shellBanner(execBanner(a), fqn, co, ro),
 		args:   args},
 	)
 	if err != nil {
@@ -375,7 +1160,10 @@ func sshIn(a *App, fqn, co string) error {
 	return nil
 }
 
-func nukeK9sShell(a *App) error {
+func nukeK9sShell(a *App, name string) error {
+	if name == "" {
+		return nil
+	}
 	ct, err := a.Config.K9s.ActiveContext()
 	if err != nil {
 		return err
@@ -385,44 +1173,186 @@ func nukeK9sShell(a *App) error {
 	}
 
 	ns := a.Config.K9s.ShellPod.Namespace
-	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
-	defer cancel()
-
 	dial, err := a.Conn().Dial()
 	if err != nil {
 		return err
 	}
+	if cmd := a.Config.K9s.ShellPod.PostExecCommand; len(cmd) > 0 {
+		runShellPostExec(a, dial.CoreV1().Pods(ns), client.FQN(ns, name), cmd)
+	}
 
-	err = dial.CoreV1().Pods(ns).Delete(ctx, k9sShellPodName(), metav1.DeleteOptions{})
-	if kerrors.IsNotFound(err) {
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	err = dial.CoreV1().Pods(ns).Delete(ctx, name, shellPodDeleteOptions(a.Config.K9s.ShellPod))
+	if err == nil || kerrors.IsNotFound(err) {
+		a.clearShellPodName(name)
 		return nil
 	}
 
 	return err
 }
 
-func launchShellPod(ctx context.Context, a *App, node string) error {
-	var (
-		spo  = a.Config.K9s.ShellPod
-		spec = k9sShellPod(node, spo)
-	)
+// shellPostExecTimeout bounds the shell pod post exec command so exiting k9s never hangs on it.
+const shellPostExecTimeout = 5 * time.Second
+
+// runShellPostExec runs the configured post exec command in a running shell pod.
+// Failures are reported but do not prevent the shell pod deletion.
+func runShellPostExec(a *App, pods typedv1.PodInterface, fqn string, cmd []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	_, n := client.Namespaced(fqn)
+	po, err := pods.Get(ctx, n, metav1.GetOptions{})
+	if err != nil || po.Status.Phase != v1.PodRunning {
+		return
+	}
+	opts := shellOpts{args: postExecArgs(fqn, cmd)}
+	if err := kubectlOpts(a, &opts); err != nil {
+		a.Flash().Errf("Shell pod post exec command failed: %s", err)
+		return
+	}
+	ectx, ecancel := context.WithTimeout(context.Background(), shellPostExecTimeout)
+	defer ecancel()
+	if _, err := capture(ectx, &opts); err != nil {
+		if errors.Is(ectx.Err(), context.DeadlineExceeded) {
+			slog.Warn("Shell pod post exec command timed out", slogs.FQN, fqn, slogs.Duration, shellPostExecTimeout)
+			a.Flash().Errf("Shell pod post exec command timed out after %s", shellPostExecTimeout)
+			return
+		}
+		slog.Warn("Shell pod post exec command failed", slogs.FQN, fqn, slogs.Error, err)
+		a.Flash().Errf("Shell pod post exec command failed: %s", err)
+	}
+}
+
+// postExecArgs returns the kubectl args to run a non interactive command in the shell pod.
+func postExecArgs(fqn string, cmd []string) []string {
+	// Drop -it as the command runs without a terminal.
+	args := slices.Delete(buildShellArgs("exec", fqn, k9sShell, nil), 1, 2)
+	args = append(args, "--")
+
+	return append(args, cmd...)
+}
+
+// criSocketEnv tells crictl which container runtime socket to use.
+const criSocketEnv = "CONTAINER_RUNTIME_ENDPOINT"
+
+// withCRISocket mounts the given container runtime socket in the shell pod.
+func withCRISocket(po *v1.Pod, sock string) {
+	t := v1.HostPathSocket
+	po.Spec.Volumes = append(po.Spec.Volumes, v1.Volume{
+		Name: "cri-sock",
+		VolumeSource: v1.VolumeSource{
+			HostPath: &v1.HostPathVolumeSource{Path: sock, Type: &t},
+		},
+	})
+	for i := range po.Spec.Containers {
+		c := &po.Spec.Containers[i]
+		c.VolumeMounts = append(c.VolumeMounts, v1.VolumeMount{Name: "cri-sock", MountPath: sock})
+		c.Env = append(c.Env, v1.EnvVar{Name: criSocketEnv, Value: "unix://" + sock})
+	}
+}
+
+// checkPullSecrets ensures the shell pod image pull secrets exist so a launch fails
+// fast rather than backing off on image pulls.
+func checkPullSecrets(ctx context.Context, secrets typedv1.SecretInterface, refs []v1.LocalObjectReference) error {
+	for _, ref := range refs {
+		if ref.Name == "" {
+			continue
+		}
+		_, err := secrets.Get(ctx, ref.Name, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			return fmt.Errorf("shell pod image pull secret %q not found", ref.Name)
+		}
+		if err != nil {
+			return fmt.Errorf("unable to check shell pod image pull secret %q: %w", ref.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// shellPodDeleteOptions returns the shell pod delete options honoring the configured grace period.
+func shellPodDeleteOptions(cfg *config.ShellPod) metav1.DeleteOptions {
+	grace := cfg.GracePeriod()
+
+	return metav1.DeleteOptions{GracePeriodSeconds: &grace}
+}
+
+// shellPodSpec builds the shell pod to be submitted for the given node.
+func shellPodSpec(ctx context.Context, nodes typedv1.NodeInterface, spo *config.ShellPod, node string, readOnly bool) (*v1.Pod, error) {
+	if !spo.IsImageAllowed(spo.Image) {
+		return nil, fmt.Errorf("shell pod image %q is not allowed", spo.Image)
+	}
+	if err := spo.ValidatePullPolicy(); err != nil {
+		return nil, err
+	}
+	if node == "" && !spo.HasScheduling() {
+		return nil, errors.New("shell pod requires a node or a node selector/affinity")
+	}
+
+	spec := k9sShellPod(k9sShellPodName(), node, spo, readOnly)
+	if spo.MountCRISocket {
+		var rt string
+		if node != "" {
+			if no, err := nodes.Get(ctx, node, metav1.GetOptions{}); err == nil {
+				rt = no.Status.NodeInfo.ContainerRuntimeVersion
+			}
+		}
+		if sock := spo.CRISocket(rt); sock != "" {
+			withCRISocket(spec, sock)
+		} else {
+			slog.Warn("Unable to detect container runtime socket. Skipping mount",
+				slogs.Node, node,
+				slogs.Runtime, rt,
+			)
+		}
+	}
+
+	return spec, nil
+}
+
+// shellPodYAML renders the given shell pod as YAML.
+func shellPodYAML(po *v1.Pod) (string, error) {
+	mm, err := runtime.DefaultUnstructuredConverter.ToUnstructured(po)
+	if err != nil {
+		return "", err
+	}
+	u := unstructured.Unstructured{Object: mm}
+	u.SetAPIVersion("v1")
+	u.SetKind("Pod")
 
+	return dao.ToYAML(&u, false)
+}
+
+// launchShellPod creates a shell pod on the given node and returns its name once created.
+func launchShellPod(ctx context.Context, a *App, node string) (string, error) {
+	spo := a.Config.K9s.ShellPod
 	dial, err := a.Conn().Dial()
 	if err != nil {
-		return err
+		return "", err
+	}
+	spec, err := shellPodSpec(ctx, dial.CoreV1().Nodes(), spo, node, readOnlyNodeShell(a))
+	if err != nil {
+		return "", err
+	}
+	if err := checkPullSecrets(ctx, dial.CoreV1().Secrets(spo.Namespace), spo.ImagePullSecrets); err != nil {
+		return "", err
 	}
 
 	conn := dial.CoreV1().Pods(spo.Namespace)
-	if _, err = conn.Create(ctx, spec, metav1.CreateOptions{}); err != nil {
-		return err
+	po, err := conn.Create(ctx, spec, metav1.CreateOptions{})
+	if err != nil {
+		return "", err
 	}
+	name := po.Name
 
 	for i := range k9sShellRetryCount {
-		o, err := a.factory.Get(client.PodGVR, client.FQN(spo.Namespace, k9sShellPodName()), true, labels.Everything())
+		o, err := a.factory.Get(client.PodGVR, client.FQN(spo.Namespace, name), true, labels.Everything())
 		if err != nil {
 			select {
 			case <-ctx.Done():
-				return ctx.Err()
+				return name, ctx.Err()
 			case <-time.After(k9sShellRetryDelay):
 				continue
 			}
@@ -430,33 +1360,42 @@ func launchShellPod(ctx context.Context, a *App, node string) error {
 
 		var pod v1.Pod
 		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &pod); err != nil {
-			return err
+			return name, err
+		}
+		if pod.Spec.NodeName != "" {
+			node = pod.Spec.NodeName
 		}
 		slog.Debug("Checking k9s shell pod retries",
 			slogs.Retry, i,
 			slogs.PodPhase, pod.Status.Phase,
+			slogs.Node, node,
 		)
 		if pod.Status.Phase == v1.PodRunning {
-			return nil
+			return name, nil
 		}
 
 		select {
 		case <-ctx.Done():
-			return ctx.Err()
+			return name, ctx.Err()
 		case <-time.After(k9sShellRetryDelay):
 		}
 	}
 
-	return fmt.Errorf("unable to launch shell pod on node %s", node)
+	if node == "" {
+		return name, errors.New("unable to launch shell pod. No node matches the shell pod node selector/affinity")
+	}
+
+	return name, fmt.Errorf("unable to launch shell pod on node %s", node)
 }
 
 func k9sShellPodName() string {
-	return fmt.Sprintf("%s-%d", k9sShell, os.Getpid())
+	return fmt.Sprintf("%s-%d-%s", k9sShell, os.Getpid(), rand.String(5))
 }
 
-func k9sShellPod(node string, cfg *config.ShellPod) *v1.Pod {
-	var grace int64
-	var priv = true
+func k9sShellPod(name, node string, cfg *config.ShellPod, readOnly bool) *v1.Pod {
+	grace := cfg.GracePeriod()
+	var priv = !readOnly
+	rootPath, rootTarget := cfg.RootMount()
 
 	slog.Debug("Shell pod config", slogs.ShellPodCfg, cfg)
 	c := v1.Container{
@@ -466,11 +1405,11 @@ func k9sShellPod(node string, cfg *config.ShellPod) *v1.Pod {
 		VolumeMounts: []v1.VolumeMount{
 			{
 				Name:      "root-vol",
-				MountPath: "/host",
+				MountPath: rootTarget,
 				ReadOnly:  true,
 			},
 		},
-		Resources: asResource(cfg.Limits),
+		Resources: asResource(cfg.Limits, cfg.Requests),
 		Stdin:     true,
 		TTY:       cfg.TTY,
 		SecurityContext: &v1.SecurityContext{
@@ -482,23 +1421,29 @@ func k9sShellPod(node string, cfg *config.ShellPod) *v1.Pod {
 			Name: "root-vol",
 			VolumeSource: v1.VolumeSource{
 				HostPath: &v1.HostPathVolumeSource{
-					Path: "/",
+					Path: rootPath,
 				},
 			},
 		},
 	}
-	if len(cfg.Command) != 0 {
-		c.Command = cfg.Command
-	}
-	if len(cfg.Args) > 0 {
-		c.Args = cfg.Args
+	if readOnly {
+		c.SecurityContext.ReadOnlyRootFilesystem = &readOnly
+		c.SecurityContext.AllowPrivilegeEscalation = &priv
+		c.Command = []string{"sh", "-c", readOnlyShellCheck}
+	} else {
+		if len(cfg.Command) != 0 {
+			c.Command = cfg.Command
+		}
+		if len(cfg.Args) > 0 {
+			c.Args = cfg.Args
+		}
 	}
 	if len(cfg.HostPathVolume) > 0 {
 		for _, h := range cfg.HostPathVolume {
 			c.VolumeMounts = append(c.VolumeMounts, v1.VolumeMount{
 				Name:      h.Name,
 				MountPath: h.MountPath,
-				ReadOnly:  h.ReadOnly,
+				ReadOnly:  h.ReadOnly || readOnly,
 			})
 			v = append(v, v1.Volume{
 				Name: h.Name,
@@ -510,11 +1455,12 @@ func k9sShellPod(node string, cfg *config.ShellPod) *v1.Pod {
 			})
 		}
 	}
-	return &v1.Pod{
+	po := v1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      k9sShellPodName(),
-			Namespace: cfg.Namespace,
-			Labels:    cfg.Labels,
+			Name:        name,
+			Namespace:   cfg.Namespace,
+			Labels:      cfg.Labels,
+			Annotations: cfg.PodAnnotations(),
 		},
 		Spec: v1.PodSpec{
 			NodeName:                      node,
@@ -525,25 +1471,105 @@ func k9sShellPod(node string, cfg *config.ShellPod) *v1.Pod {
 			TerminationGracePeriodSeconds: &grace,
 			Volumes:                       v,
 			Containers:                    []v1.Container{c},
-			Tolerations: []v1.Toleration{
-				{
-					Operator: v1.TolerationOperator("Exists"),
-				},
-			},
+			Tolerations:                   cfg.PodTolerations(),
 		},
 	}
+	// Let the scheduler place the pod when no node is pinned.
+	if node == "" {
+		po.Spec.NodeSelector, po.Spec.Affinity = cfg.NodeSelector, cfg.Affinity
+	}
+
+	return &po
 }
 
-func asResource(r config.Limits) v1.ResourceRequirements {
+func asResource(limits, requests config.Limits) v1.ResourceRequirements {
+	if len(requests) == 0 {
+		requests = limits
+	}
+
 	return v1.ResourceRequirements{
-		Limits: v1.ResourceList{
-			v1.ResourceCPU:    resource.MustParse(r[v1.ResourceCPU]),
-			v1.ResourceMemory: resource.MustParse(r[v1.ResourceMemory]),
-		},
+		Limits:   asResourceList(limits),
+		Requests: asResourceList(requests),
+	}
+}
+
+func asResourceList(l config.Limits) v1.ResourceList {
+	if len(l) == 0 {
+		return nil
+	}
+	rl := make(v1.ResourceList, len(l))
+	for k, v := range l {
+		q, err := resource.ParseQuantity(v)
+		if err != nil {
+			slog.Warn("Invalid shell pod resource quantity",
+				slogs.Name, k,
+				slogs.Error, err,
+			)
+			continue
+		}
+		rl[k] = q
+	}
+
+	return rl
+}
+
+// prefixLine formats a background command output line.
+func prefixLine(l string) string {
+	return fmt.Sprintf("%s %s", outputPrefix, l)
+}
+
+// transientErrors tracks stderr patterns of API failures worth retrying.
+var transientErrors = []string{
+	"TooManyRequests",
+	"429",
+	"connection reset by peer",
+	"TLS handshake timeout",
+	"i/o timeout",
+}
+
+// isTransient checks if a command stderr signals a transient API failure.
+func isTransient(stderr string) bool {
+	for _, p := range transientErrors {
+		if strings.Contains(stderr, p) {
+			return true
+		}
 	}
+
+	return false
 }
 
-func pipe(_ context.Context, opts *shellOpts, statusChan chan<- string, w, e *bytes.Buffer, cmds ...*exec.Cmd) error {
+// runRetry runs a background command, retrying it with an exponential backoff
+// when it fails with a transient error.
+func runRetry(ctx context.Context, opts *shellOpts, cmd *exec.Cmd, w, e *bytes.Buffer) error {
+	for attempt := 0; ; attempt++ {
+		err := cmd.Run()
+		if err == nil || attempt >= opts.retries || !isTransient(e.String()) {
+			return err
+		}
+		delay := opts.backoff << attempt
+		slog.Warn("Transient command failure",
+			slogs.Command, opts.Redacted(),
+			slogs.Retry, attempt+1,
+			slogs.Error, err,
+		)
+		if opts.onRetry != nil {
+			opts.onRetry(attempt+1, delay)
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+		next := exec.CommandContext(ctx, cmd.Path, cmd.Args[1:]...)
+		next.Env, next.Dir = cmd.Env, cmd.Dir
+		next.Stdin, next.Stdout, next.Stderr = cmd.Stdin, cmd.Stdout, cmd.Stderr
+		w.Reset()
+		e.Reset()
+		cmd = next
+	}
+}
+
+func pipe(ctx context.Context, opts *shellOpts, statusChan chan<- string, w, e *bytes.Buffer, cmds ...*exec.Cmd) error {
 	if len(cmds) == 0 {
 		return nil
 	}
@@ -551,14 +1577,18 @@ func pipe(_ context.Context, opts *shellOpts, statusChan chan<- string, w, e *by
 	if len(cmds) == 1 {
 		cmd := cmds[0]
 		if opts.background {
+			format := opts.formatLine
+			if format == nil {
+				format = prefixLine
+			}
 			go func() {
 				cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, w, e
-				if err := cmd.Run(); err != nil {
+				if err := runRetry(ctx, opts, cmd, w, e); err != nil {
 					slog.Error("Command exec failed", slogs.Error, err)
 				} else {
 					for _, l := range strings.Split(w.String(), "\n") {
 						if l != "" {
-							statusChan <- fmt.Sprintf("%s %s", outputPrefix, l)
+							statusChan <- format(l)
 						}
 					}
 					statusChan <- fmt.Sprintf("Command completed successfully: %q", render.Truncate(cmd.String(), 20))
@@ -568,8 +1598,14 @@ func pipe(_ context.Context, opts *shellOpts, statusChan chan<- string, w, e *by
 			}()
 			return nil
 		}
-		cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
-		_, _ = cmd.Stdout.Write([]byte(opts.banner))
+		if opts.captureToPager {
+			cmd.Stdout, cmd.Stderr = w, e
+		} else {
+			cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+			if opts.banner != "" {
+				_, _ = cmd.Stdout.Write([]byte(opts.banner))
+			}
+		}
 
 		slog.Debug("Exec started")
 		err := cmd.Run()
@@ -583,14 +1619,28 @@ func pipe(_ context.Context, opts *shellOpts, statusChan chan<- string, w, e *by
 	}
 
 	last := len(cmds) - 1
+	// Each stage gets its own stderr buffer since stages write concurrently.
+	var errs []bytes.Buffer
+	if opts.captureToPager {
+		errs = make([]bytes.Buffer, len(cmds))
+	}
 	for i := range cmds {
 		cmds[i].Stderr = os.Stderr
+		if opts.captureToPager {
+			cmds[i].Stderr = &errs[i]
+		}
 		if i+1 < len(cmds) {
-			r, w := io.Pipe()
-			cmds[i].Stdout, cmds[i+1].Stdin = w, r
+			r, err := cmds[i].StdoutPipe()
+			if err != nil {
+				return err
+			}
+			cmds[i+1].Stdin = r
 		}
 	}
 	cmds[last].Stdout = os.Stdout
+	if opts.captureToPager {
+		cmds[last].Stdout = w
+	}
 
 	for _, cmd := range cmds {
 		slog.Debug("Starting command", slogs.Command, cmd)
@@ -599,5 +1649,15 @@ func pipe(_ context.Context, opts *shellOpts, statusChan chan<- string, w, e *by
 		}
 	}
 
-	return cmds[len(cmds)-1].Wait()
+	err := cmds[last].Wait()
+	for _, cmd := range cmds[:last] {
+		if werr := cmd.Wait(); werr != nil {
+			slog.Debug("Pipe stage exited", slogs.Command, cmd, slogs.Error, werr)
+		}
+	}
+	for i := range errs {
+		_, _ = e.Write(errs[i].Bytes())
+	}
+
+	return err
 }
@@ -0,0 +1,1529 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/config/mock"
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestOneShoot(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh is not available")
+	}
+
+	uu := map[string]struct {
+		opts shellOpts
+		e    string
+		err  string
+	}{
+		"stdout": {
+			opts: shellOpts{binary: sh, args: []string{"-c", "echo fred"}},
+			e:    "fred",
+		},
+		"warn-on-stderr": {
+			opts: shellOpts{binary: sh, args: []string{"-c", "echo deprecated >&2; echo fred"}},
+			e:    "fred",
+		},
+		"banner": {
+			opts: shellOpts{binary: sh, banner: "blee\n", args: []string{"-c", "echo fred >&2; echo duh"}},
+			e:    "blee\nduh",
+		},
+		"disabled-banner": {
+			opts: shellOpts{binary: sh, banner: shellBanner(banner{disabled: true}, "fred/blee", "c1", false), args: []string{"-c", "echo duh"}},
+			e:    "duh",
+		},
+		"failed": {
+			opts: shellOpts{binary: sh, args: []string{"-c", "echo fred; echo boom >&2; exit 1"}},
+			e:    "fred",
+			err:  "exit status 1: boom",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			res, err := oneShoot(&u.opts)
+			if u.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, u.err, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, u.e, res)
+		})
+	}
+}
+
+func TestStreamShoot(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh is not available")
+	}
+
+	uu := map[string]struct {
+		script string
+		e      []string
+		err    string
+	}{
+		"lines": {
+			script: "for i in 1 2 3; do echo line-$i; sleep 0.05; done",
+			e:      []string{"line-1", "line-2", "line-3"},
+		},
+		"no-trailing-newline": {
+			script: "echo fred; printf blee",
+			e:      []string{"fred", "blee"},
+		},
+		"failed": {
+			script: "echo fred; echo boom >&2; exit 1",
+			e:      []string{"fred"},
+			err:    "exit status 1: boom",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var ll []string
+			err := streamShoot(context.Background(), &shellOpts{binary: sh, args: []string{"-c", u.script}}, func(l string) {
+				ll = append(ll, l)
+			})
+			if u.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, u.err, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, u.e, ll)
+		})
+	}
+}
+
+func TestStreamShootIncremental(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh is not available")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	opts := shellOpts{binary: sh, args: []string{"-c", "echo fred; echo blee; exec sleep 30"}}
+	lines := make(chan string, 2)
+	errc := make(chan error, 1)
+	go func() {
+		errc <- streamShoot(ctx, &opts, func(l string) { lines <- l })
+	}()
+
+	for _, e := range []string{"fred", "blee"} {
+		select {
+		case l := <-lines:
+			assert.Equal(t, e, l)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("line %q was not streamed before the command exited", e)
+		}
+	}
+	cancel()
+	select {
+	case err := <-errc:
+		require.ErrorIs(t, err, context.Canceled)
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream did not stop on cancel")
+	}
+}
+
+func TestK9sShellPodName(t *testing.T) {
+	n1, n2 := k9sShellPodName(), k9sShellPodName()
+
+	assert.NotEqual(t, n1, n2)
+	assert.True(t, strings.HasPrefix(n1, fmt.Sprintf("%s-%d-", k9sShell, os.Getpid())))
+	assert.Equal(t, n1, k9sShellPod(n1, "node-1", config.NewShellPod(), false).Name)
+}
+
+func TestDescribeArgs(t *testing.T) {
+	uu := map[string]struct {
+		gvr *client.GVR
+		fqn string
+		e   []string
+	}{
+		"namespaced": {
+			gvr: client.PodGVR,
+			fqn: "fred/blee",
+			e:   []string{"describe", "-n", "fred", "pods", "blee"},
+		},
+		"grouped": {
+			gvr: client.DpGVR,
+			fqn: "fred/blee",
+			e:   []string{"describe", "-n", "fred", "deployments.v1.apps", "blee"},
+		},
+		"cluster-scoped": {
+			gvr: client.NodeGVR,
+			fqn: "n1",
+			e:   []string{"describe", "nodes", "n1"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, describeArgs(u.gvr, u.fqn))
+		})
+	}
+}
+
+func TestMergeEnv(t *testing.T) {
+	uu := map[string]struct {
+		base []string
+		env  map[string]string
+		e    []string
+	}{
+		"empty": {
+			base: []string{"A=1"},
+			e:    []string{"A=1"},
+		},
+		"add": {
+			base: []string{"A=1"},
+			env:  map[string]string{"C": "3", "B": "2"},
+			e:    []string{"A=1", "B=2", "C=3"},
+		},
+		"override": {
+			base: []string{"A=1", "HTTPS_PROXY=http://fred", "B=2"},
+			env:  map[string]string{"HTTPS_PROXY": "http://blee"},
+			e:    []string{"A=1", "B=2", "HTTPS_PROXY=http://blee"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, mergeEnv(u.base, u.env))
+		})
+	}
+}
+
+func TestOneShootEnv(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh is not available")
+	}
+	t.Setenv("K9S_FRED", "blee")
+
+	res, err := oneShoot(&shellOpts{
+		binary: sh,
+		args:   []string{"-c", "echo $K9S_FRED-$K9S_BLEE"},
+		env:    map[string]string{"K9S_FRED": "duh", "K9S_BLEE": "zorg"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "duh-zorg", res)
+}
+
+func TestAnsiEnabled(t *testing.T) {
+	uu := map[string]struct {
+		tty           bool
+		noColor, term string
+		e             bool
+	}{
+		"tty": {
+			tty:  true,
+			term: "xterm",
+			e:    true,
+		},
+		"no-tty": {
+			term: "xterm",
+		},
+		"dumb": {
+			tty:  true,
+			term: "dumb",
+		},
+		"no-color": {
+			tty:     true,
+			term:    "xterm",
+			noColor: "1",
+		},
+	}
+
+	defer func(f func(uintptr) bool) { isTerminal = f }(isTerminal)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			isTerminal = func(uintptr) bool { return u.tty }
+			t.Setenv("NO_COLOR", u.noColor)
+			t.Setenv("TERM", u.term)
+			assert.Equal(t, u.e, ansiEnabled())
+		})
+	}
+}
+
+func TestShellBannerNoColor(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+
+	assert.Equal(t, "<<K9s-Shell>> Pod: fred/blee | Container: c1 \n", shellBanner(banner{}, "fred/blee", "c1", false))
+}
+
+func TestK9sShellPodReadOnly(t *testing.T) {
+	uu := map[string]struct {
+		ro   bool
+		priv bool
+		cfg  []string
+		cmd  []string
+	}{
+		"default": {
+			priv: true,
+		},
+		"custom": {
+			priv: true,
+			cfg:  []string{"bash"},
+			cmd:  []string{"bash"},
+		},
+		"read-only": {
+			ro:  true,
+			cmd: []string{"sh", "-c", readOnlyShellCheck},
+		},
+		"read-only-custom": {
+			ro:  true,
+			cfg: []string{"bash"},
+			cmd: []string{"sh", "-c", readOnlyShellCheck},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg := config.NewShellPod()
+			cfg.Command = u.cfg
+			po := k9sShellPod("fred", "node-1", cfg, u.ro)
+			require.Len(t, po.Spec.Containers, 1)
+			co := po.Spec.Containers[0]
+			assert.Equal(t, u.priv, *co.SecurityContext.Privileged)
+			assert.Equal(t, u.cmd, co.Command)
+			for _, m := range co.VolumeMounts {
+				if u.ro {
+					assert.True(t, m.ReadOnly)
+				}
+			}
+			if u.ro {
+				assert.True(t, *co.SecurityContext.ReadOnlyRootFilesystem)
+				assert.False(t, *co.SecurityContext.AllowPrivilegeEscalation)
+			} else {
+				assert.Nil(t, co.SecurityContext.ReadOnlyRootFilesystem)
+			}
+		})
+	}
+}
+
+func TestShellBannerFormat(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+
+	uu := map[string]struct {
+		b        banner
+		readOnly bool
+		e        string
+	}{
+		"default": {
+			e: "<<K9s-Shell>> Pod: fred/blee | Container: c1 \n",
+		},
+		"disabled": {
+			b: banner{disabled: true},
+		},
+		"disabled-read-only": {
+			b:        banner{disabled: true},
+			readOnly: true,
+		},
+		"custom": {
+			b: banner{format: "{{.Container}} on {{.Pod}}"},
+			e: "c1 on fred/blee \n",
+		},
+		"custom-read-only": {
+			b:        banner{format: "{{.Container}} on {{.Pod}}"},
+			readOnly: true,
+			e:        "c1 on fred/blee | READ-ONLY \n",
+		},
+		"invalid": {
+			b: banner{format: "{{.Zorg"},
+			e: "<<K9s-Shell>> Pod: fred/blee | Container: c1 \n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, shellBanner(u.b, "fred/blee", "c1", u.readOnly))
+		})
+	}
+}
+
+func TestRootShellBanner(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+
+	assert.Equal(t, "<<K9s-Shell>> Pod: fred/blee | Container: c1 | ROOT \n", rootShellBanner(banner{}, "fred/blee", "c1"))
+	assert.Empty(t, rootShellBanner(banner{disabled: true}, "fred/blee", "c1"))
+}
+
+func TestShellBannerColors(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+	t.Setenv("TERM", "xterm")
+	nc := color.NoColor
+	color.NoColor = false
+	t.Cleanup(func() { color.NoColor = nc })
+
+	b := banner{fg: "#000000", bg: "#ffffff"}
+	s := shellBanner(b, "fred/blee", "c1", false)
+	assert.Contains(t, s, "48;2;255;255;255")
+	assert.Contains(t, s, "Pod: fred/blee")
+}
+
+func TestShellBannerReadOnly(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+
+	assert.Equal(t, "<<K9s-Shell>> Pod: fred/blee | Container: c1 | READ-ONLY \n", shellBanner(banner{}, "fred/blee", "c1", true))
+}
+
+func TestPipeBackgroundFormat(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh is not available")
+	}
+
+	uu := map[string]struct {
+		format func(string) string
+		e      []string
+	}{
+		"default": {
+			e: []string{"[output] fred", "[output] blee"},
+		},
+		"custom": {
+			format: strings.ToUpper,
+			e:      []string{"FRED", "BLEE"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			statusChan := make(chan string, 10)
+			opts := shellOpts{background: true, formatLine: u.format}
+			cmd := exec.Command(sh, "-c", "echo fred; echo blee")
+			var w, e bytes.Buffer
+			require.NoError(t, pipe(context.Background(), &opts, statusChan, &w, &e, cmd))
+
+			var ll []string
+			for l := range statusChan {
+				ll = append(ll, l)
+			}
+			require.Len(t, ll, 3)
+			assert.Equal(t, u.e, ll[:2])
+		})
+	}
+}
+
+func TestK9sShellPodRootMount(t *testing.T) {
+	uu := map[string]struct {
+		path, target   string
+		ePath, eTarget string
+	}{
+		"default": {
+			ePath:   "/",
+			eTarget: "/host",
+		},
+		"custom": {
+			path:    "/var/log",
+			target:  "/logs",
+			ePath:   "/var/log",
+			eTarget: "/logs",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg := config.NewShellPod()
+			cfg.RootMountPath, cfg.RootMountTarget = u.path, u.target
+
+			po := k9sShellPod("fred", "node-1", cfg, false)
+			require.NotEmpty(t, po.Spec.Volumes)
+			assert.Equal(t, "root-vol", po.Spec.Volumes[0].Name)
+			assert.Equal(t, u.ePath, po.Spec.Volumes[0].HostPath.Path)
+			m := po.Spec.Containers[0].VolumeMounts[0]
+			assert.Equal(t, "root-vol", m.Name)
+			assert.Equal(t, u.eTarget, m.MountPath)
+			assert.True(t, m.ReadOnly)
+		})
+	}
+}
+
+func TestK9sShellPodTolerations(t *testing.T) {
+	uu := map[string]struct {
+		tt []v1.Toleration
+		e  []v1.Toleration
+	}{
+		"default": {
+			e: []v1.Toleration{{Operator: v1.TolerationOpExists}},
+		},
+		"custom": {
+			tt: []v1.Toleration{{Key: "gpu", Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule}},
+			e:  []v1.Toleration{{Key: "gpu", Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg := config.NewShellPod()
+			cfg.Tolerations = u.tt
+			po := k9sShellPod("fred", "node-1", cfg, false)
+			assert.Equal(t, u.e, po.Spec.Tolerations)
+		})
+	}
+}
+
+func TestK9sShellPodScheduling(t *testing.T) {
+	aff := v1.Affinity{
+		NodeAffinity: &v1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+				NodeSelectorTerms: []v1.NodeSelectorTerm{
+					{
+						MatchExpressions: []v1.NodeSelectorRequirement{
+							{Key: "pool", Operator: v1.NodeSelectorOpIn, Values: []string{"debug"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	uu := map[string]struct {
+		node     string
+		selector map[string]string
+		aff      *v1.Affinity
+		eNode    string
+		eSel     map[string]string
+		eAff     *v1.Affinity
+	}{
+		"pinned": {
+			node:  "node-1",
+			eNode: "node-1",
+		},
+		"pinned-with-selectors": {
+			node:     "node-1",
+			selector: map[string]string{"pool": "debug"},
+			aff:      &aff,
+			eNode:    "node-1",
+		},
+		"scheduled": {
+			selector: map[string]string{"pool": "debug"},
+			aff:      &aff,
+			eSel:     map[string]string{"pool": "debug"},
+			eAff:     &aff,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg := config.NewShellPod()
+			cfg.NodeSelector, cfg.Affinity = u.selector, u.aff
+			po := k9sShellPod("fred", u.node, cfg, false)
+			assert.Equal(t, u.eNode, po.Spec.NodeName)
+			assert.Equal(t, u.eSel, po.Spec.NodeSelector)
+			assert.Equal(t, u.eAff, po.Spec.Affinity)
+		})
+	}
+}
+
+func TestAsResource(t *testing.T) {
+	uu := map[string]struct {
+		limits, requests config.Limits
+		e                v1.ResourceRequirements
+	}{
+		"limits-only": {
+			limits: config.Limits{v1.ResourceCPU: "100m", v1.ResourceMemory: "100Mi"},
+			e: v1.ResourceRequirements{
+				Limits: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("100m"),
+					v1.ResourceMemory: resource.MustParse("100Mi"),
+				},
+				Requests: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("100m"),
+					v1.ResourceMemory: resource.MustParse("100Mi"),
+				},
+			},
+		},
+		"requests-only": {
+			requests: config.Limits{v1.ResourceCPU: "50m"},
+			e: v1.ResourceRequirements{
+				Requests: v1.ResourceList{
+					v1.ResourceCPU: resource.MustParse("50m"),
+				},
+			},
+		},
+		"both": {
+			limits:   config.Limits{v1.ResourceCPU: "200m", v1.ResourceMemory: "200Mi"},
+			requests: config.Limits{v1.ResourceCPU: "50m", v1.ResourceMemory: "64Mi"},
+			e: v1.ResourceRequirements{
+				Limits: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("200m"),
+					v1.ResourceMemory: resource.MustParse("200Mi"),
+				},
+				Requests: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("50m"),
+					v1.ResourceMemory: resource.MustParse("64Mi"),
+				},
+			},
+		},
+		"invalid": {
+			limits: config.Limits{v1.ResourceCPU: "fred", v1.ResourceMemory: "100Mi"},
+			e: v1.ResourceRequirements{
+				Limits: v1.ResourceList{
+					v1.ResourceMemory: resource.MustParse("100Mi"),
+				},
+				Requests: v1.ResourceList{
+					v1.ResourceMemory: resource.MustParse("100Mi"),
+				},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, asResource(u.limits, u.requests))
+		})
+	}
+}
+
+func TestK9sShellPodAnnotations(t *testing.T) {
+	uu := map[string]struct {
+		aa map[string]string
+		e  map[string]string
+	}{
+		"default": {
+			e: map[string]string{"sidecar.istio.io/inject": "false"},
+		},
+		"custom": {
+			aa: map[string]string{"fred": "blee"},
+			e:  map[string]string{"sidecar.istio.io/inject": "false", "fred": "blee"},
+		},
+		"override": {
+			aa: map[string]string{"sidecar.istio.io/inject": "true"},
+			e:  map[string]string{"sidecar.istio.io/inject": "true"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg := config.NewShellPod()
+			cfg.Annotations = u.aa
+			po := k9sShellPod("fred", "node-1", cfg, false)
+			assert.Equal(t, u.e, po.Annotations)
+		})
+	}
+}
+
+func TestWithTTYSize(t *testing.T) {
+	uu := map[string]struct {
+		w, h int
+		err  error
+		env  map[string]string
+		e    map[string]string
+	}{
+		"size": {
+			w: 120,
+			h: 40,
+			e: map[string]string{"COLUMNS": "120", "LINES": "40"},
+		},
+		"merged": {
+			w:   120,
+			h:   40,
+			env: map[string]string{"FRED": "blee", "LINES": "10"},
+			e:   map[string]string{"COLUMNS": "120", "LINES": "10", "FRED": "blee"},
+		},
+		"no-tty": {
+			err: errors.New("not a terminal"),
+			env: map[string]string{"FRED": "blee"},
+			e:   map[string]string{"FRED": "blee"},
+		},
+	}
+
+	defer func(f func(uintptr) (int, int, error)) { termSize = f }(termSize)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			termSize = func(uintptr) (int, int, error) { return u.w, u.h, u.err }
+			assert.Equal(t, u.e, withTTYSize(u.env))
+		})
+	}
+}
+
+func TestExecuteTTYSize(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh is not available")
+	}
+	defer func(f func(uintptr) (int, int, error)) { termSize = f }(termSize)
+	termSize = func(uintptr) (int, int, error) { return 80, 24, nil }
+
+	out := filepath.Join(t.TempDir(), "size")
+	opts := shellOpts{binary: sh, args: []string{"-c", "echo $COLUMNS $LINES > " + out}}
+	require.NoError(t, execute(context.Background(), &opts, make(chan string, 1)))
+	bb, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.Equal(t, "80 24\n", string(bb))
+}
+
+func TestShellPodWatchdog(t *testing.T) {
+	uu := map[string]struct {
+		cancel bool
+		e      int32
+	}{
+		"expired": {
+			e: 1,
+		},
+		"canceled": {
+			cancel: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if u.cancel {
+				cancel()
+			}
+
+			var count atomic.Int32
+			shellPodWatchdog(ctx, 10*time.Millisecond, func() { count.Add(1) })
+			assert.Equal(t, u.e, count.Load())
+		})
+	}
+}
+
+func TestExecuteClearScreen(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh is not available")
+	}
+
+	uu := map[string]struct {
+		args      []string
+		interrupt bool
+		err       bool
+		e         int
+	}{
+		"completed": {
+			args: []string{"-c", "true"},
+			e:    1,
+		},
+		"failed": {
+			args: []string{"-c", "exit 1"},
+			err:  true,
+			e:    1,
+		},
+		"interrupted": {
+			args:      []string{"-c", "exec sleep 5"},
+			interrupt: true,
+			err:       true,
+		},
+	}
+
+	defer func(f func()) { clearScreen = f }(clearScreen)
+	defer func(f func(chan<- os.Signal)) { notifySignals = f }(notifySignals)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var count int
+			clearScreen = func() { count++ }
+			notifySignals = func(c chan<- os.Signal) {
+				if u.interrupt {
+					c <- os.Interrupt
+				}
+			}
+
+			opts := shellOpts{binary: sh, args: u.args}
+			err := execute(context.Background(), &opts, make(chan string, 1))
+			if u.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, u.e, count)
+		})
+	}
+}
+
+func TestExecuteNoLeaks(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh is not available")
+	}
+	defer goleak.VerifyNone(t,
+		goleak.IgnoreCurrent(),
+		goleak.IgnoreAnyFunction("os/signal.loop"),
+	)
+
+	for range 10 {
+		opts := shellOpts{binary: sh, args: []string{"-c", "true"}}
+		require.NoError(t, execute(context.Background(), &opts, make(chan string, 1)))
+	}
+	opts := shellOpts{binary: sh, args: []string{"-c", "true"}, background: true}
+	c := make(chan string, 10)
+	require.NoError(t, execute(context.Background(), &opts, c))
+	for range c {
+	}
+}
+
+func TestKubectlPath(t *testing.T) {
+	dir := t.TempDir()
+	for _, b := range []string{"kubectl", "oc", "kubectl.1.29"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, b), []byte("#!/bin/sh\n"), 0o755))
+	}
+
+	uu := map[string]struct {
+		env, cfg string
+		e        string
+		err      bool
+	}{
+		"fallback": {
+			e: filepath.Join(dir, "kubectl"),
+		},
+		"config-name": {
+			cfg: "kubectl.1.29",
+			e:   filepath.Join(dir, "kubectl.1.29"),
+		},
+		"config-path": {
+			cfg: filepath.Join(dir, "oc"),
+			e:   filepath.Join(dir, "oc"),
+		},
+		"env": {
+			env: "oc",
+			cfg: "kubectl.1.29",
+			e:   filepath.Join(dir, "oc"),
+		},
+		"missing": {
+			env: "blee",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			t.Setenv("PATH", dir)
+			t.Setenv(kubectlEnv, u.env)
+			bin, err := kubectlPath(u.cfg)
+			if u.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.e, bin)
+		})
+	}
+}
+
+func TestKubectlPathErrDot(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "oc"), []byte("#!/bin/sh\n"), 0o755))
+	t.Chdir(dir)
+	t.Setenv("PATH", ".")
+	t.Setenv(kubectlEnv, "oc")
+
+	_, err := kubectlPath("")
+	require.ErrorIs(t, err, ErrKubectlInCWD)
+	assert.ErrorIs(t, err, exec.ErrDot)
+}
+
+func TestIsInteractive(t *testing.T) {
+	uu := map[string]struct {
+		args []string
+		e    bool
+	}{
+		"describe": {
+			args: []string{"describe", "pod", "fred", "-n", "blee"},
+		},
+		"logs": {
+			args: []string{"logs", "fred", "--context", "exec"},
+		},
+		"exec": {
+			args: []string{"exec", "fred", "--", "ls"},
+			e:    true,
+		},
+		"edit": {
+			args: []string{"--context", "blee", "edit", "pod/fred"},
+			e:    true,
+		},
+		"tty": {
+			args: []string{"run", "fred", "-it"},
+			e:    true,
+		},
+		"stdin": {
+			args: []string{"get", "pods", "--stdin"},
+			e:    true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, isInteractive(u.args))
+		})
+	}
+}
+
+func TestCapture(t *testing.T) {
+	uu := map[string]struct {
+		opts shellOpts
+		e    string
+		err  error
+	}{
+		"plain": {
+			opts: shellOpts{binary: "sh", args: []string{"-c", "echo fred"}},
+			e:    "fred\n",
+		},
+		"piped": {
+			opts: shellOpts{binary: "sh", args: []string{"-c", "printf 'fred\nblee\n'"}, pipes: []string{"grep blee"}},
+			e:    "blee\n",
+		},
+		"interactive": {
+			opts: shellOpts{binary: "kubectl", args: []string{"exec", "-it", "fred"}},
+			err:  errInteractive,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			out, err := capture(context.Background(), &u.opts)
+			if u.err != nil {
+				require.ErrorIs(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.e, out)
+		})
+	}
+}
+
+func TestShellOptsRedacted(t *testing.T) {
+	uu := map[string]struct {
+		opts shellOpts
+		e    string
+	}{
+		"plain": {
+			opts: shellOpts{binary: "kubectl", args: []string{"get", "pods", "--context", "fred"}},
+			e:    "kubectl get pods --context fred",
+		},
+		"token": {
+			opts: shellOpts{binary: "kubectl", args: []string{"--token", "s3cr3t", "get", "pods"}},
+			e:    "kubectl --token <redacted> get pods",
+		},
+		"password-eq": {
+			opts: shellOpts{binary: "kubectl", args: []string{"get", "pods", "--password=s3cr3t"}},
+			e:    "kubectl get pods --password=<redacted>",
+		},
+		"pipes": {
+			opts: shellOpts{binary: "kubectl", args: []string{"logs", "fred"}, pipes: []string{"grep blee"}},
+			e:    "kubectl logs fred | grep blee",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.opts.Redacted())
+		})
+	}
+}
+
+func TestEditorArgs(t *testing.T) {
+	uu := map[string]struct {
+		tokens []string
+		line   int
+		e      []string
+	}{
+		"none": {
+			tokens: []string{"-w"},
+			line:   10,
+			e:      []string{"-w"},
+		},
+		"vim": {
+			tokens: []string{"+{line}"},
+			line:   10,
+			e:      []string{"+10"},
+		},
+		"colon": {
+			tokens: []string{"-n", ":{line}"},
+			line:   3,
+			e:      []string{"-n", ":3"},
+		},
+		"no-line": {
+			tokens: []string{"+{line}", "-w"},
+			e:      []string{"-w"},
+		},
+		"no-line-embedded": {
+			tokens: []string{"--goto=:{line}"},
+			e:      []string{"--goto="},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, editorArgs(u.tokens, u.line))
+		})
+	}
+}
+
+func TestShellPods(t *testing.T) {
+	var pp shellPods
+	assert.Empty(t, pp.last())
+
+	pp.add("fred")
+	pp.add("blee")
+	pp.add("fred")
+	assert.Equal(t, "blee", pp.last())
+	assert.Equal(t, []string{"fred", "blee"}, pp.names())
+
+	pp.remove("blee")
+	assert.Equal(t, "fred", pp.last())
+	pp.remove("zorg")
+	assert.Equal(t, []string{"fred"}, pp.names())
+}
+
+func TestShellPodGracePeriod(t *testing.T) {
+	grace := int64(30)
+	uu := map[string]struct {
+		grace *int64
+		e     int64
+	}{
+		"default": {},
+		"custom": {
+			grace: &grace,
+			e:     30,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg := config.NewShellPod()
+			cfg.TerminationGracePeriodSeconds = u.grace
+
+			po := k9sShellPod("fred", "node-1", cfg, false)
+			require.NotNil(t, po.Spec.TerminationGracePeriodSeconds)
+			assert.Equal(t, u.e, *po.Spec.TerminationGracePeriodSeconds)
+
+			opts := shellPodDeleteOptions(cfg)
+			require.NotNil(t, opts.GracePeriodSeconds)
+			assert.Equal(t, u.e, *opts.GracePeriodSeconds)
+		})
+	}
+}
+
+func TestPostExecArgs(t *testing.T) {
+	uu := map[string]struct {
+		fqn string
+		cmd []string
+		e   []string
+	}{
+		"namespaced": {
+			fqn: "default/k9s-shell-1",
+			cmd: []string{"rm", "-rf", "/host/tmp/fred"},
+			e:   []string{"exec", "-n", "default", "k9s-shell-1", "-c", k9sShell, "--", "rm", "-rf", "/host/tmp/fred"},
+		},
+		"shell": {
+			fqn: "k9s/k9s-shell-2",
+			cmd: []string{"sh", "-c", "sync"},
+			e:   []string{"exec", "-n", "k9s", "k9s-shell-2", "-c", k9sShell, "--", "sh", "-c", "sync"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, postExecArgs(u.fqn, u.cmd))
+		})
+	}
+}
+
+func TestPipeArgs(t *testing.T) {
+	uu := map[string]struct {
+		pipe string
+		e    []string
+	}{
+		"simple": {
+			pipe: "grep fred",
+			e:    []string{"grep", "fred"},
+		},
+		"single": {
+			pipe: "sort",
+			e:    []string{"sort"},
+		},
+		"double-quotes": {
+			pipe: `grep "foo bar"`,
+			e:    []string{"grep", "foo bar"},
+		},
+		"single-quotes": {
+			pipe: `awk '{print $1}'`,
+			e:    []string{"awk", "{print $1}"},
+		},
+		"extra-spaces": {
+			pipe: "grep  -i   fred",
+			e:    []string{"grep", "-i", "fred"},
+		},
+		"unbalanced": {
+			pipe: `grep "fred`,
+			e:    []string{"grep", `"fred`},
+		},
+		"empty": {
+			e: []string{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, pipeArgs(u.pipe))
+		})
+	}
+}
+
+func TestCaptureQuotedPipe(t *testing.T) {
+	opts := shellOpts{
+		binary: "sh",
+		args:   []string{"-c", "printf 'foo bar\nfoo\n'"},
+		pipes:  []string{`grep "foo bar"`},
+	}
+	out, err := capture(context.Background(), &opts)
+	require.NoError(t, err)
+	assert.Equal(t, "foo bar\n", out)
+}
+
+func TestPageOutputInteractive(t *testing.T) {
+	a := NewApp(mock.NewMockConfig(t))
+	opts := shellOpts{binary: "kubectl", args: []string{"exec", "-it", "fred"}}
+
+	require.ErrorIs(t, pageOutput(a, &opts, "Output"), errInteractive)
+}
+
+func TestLimitedWriter(t *testing.T) {
+	uu := map[string]struct {
+		n         int64
+		ww        []string
+		e         string
+		truncated bool
+	}{
+		"under": {
+			n:  10,
+			ww: []string{"fred"},
+			e:  "fred",
+		},
+		"exact": {
+			n:  8,
+			ww: []string{"fred", "blee"},
+			e:  "fredblee",
+		},
+		"over": {
+			n:         6,
+			ww:        []string{"fred", "blee", "zorg"},
+			e:         "fredbl",
+			truncated: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var b bytes.Buffer
+			w := limitedWriter{w: &b, n: u.n}
+			for _, s := range u.ww {
+				n, err := w.Write([]byte(s))
+				require.NoError(t, err)
+				assert.Len(t, s, n)
+			}
+			assert.Equal(t, u.e, b.String())
+			assert.Equal(t, u.truncated, w.truncated)
+		})
+	}
+}
+
+func TestOneShootMaxOutput(t *testing.T) {
+	out, err := oneShoot(&shellOpts{
+		binary:         "sh",
+		args:           []string{"-c", "printf 'fred blee zorg'"},
+		maxOutputBytes: 4,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "fred\n... output truncated at 4 bytes", out)
+}
+
+func TestCheckPullSecrets(t *testing.T) {
+	cs := fake.NewClientset(&v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "regcred", Namespace: "default"}})
+
+	uu := map[string]struct {
+		refs []v1.LocalObjectReference
+		err  string
+	}{
+		"none": {},
+		"exists": {
+			refs: []v1.LocalObjectReference{{Name: "regcred"}},
+		},
+		"missing": {
+			refs: []v1.LocalObjectReference{{Name: "regcred"}, {Name: "mirror"}},
+			err:  `shell pod image pull secret "mirror" not found`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := checkPullSecrets(context.Background(), cs.CoreV1().Secrets("default"), u.refs)
+			if u.err != "" {
+				require.EqualError(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWithCRISocket(t *testing.T) {
+	po := k9sShellPod("fred", "node-1", config.NewShellPod(), false)
+	withCRISocket(po, "/run/containerd/containerd.sock")
+
+	v := po.Spec.Volumes[len(po.Spec.Volumes)-1]
+	assert.Equal(t, "cri-sock", v.Name)
+	require.NotNil(t, v.HostPath)
+	assert.Equal(t, "/run/containerd/containerd.sock", v.HostPath.Path)
+	assert.Equal(t, v1.HostPathSocket, *v.HostPath.Type)
+
+	c := po.Spec.Containers[0]
+	assert.Contains(t, c.VolumeMounts, v1.VolumeMount{Name: "cri-sock", MountPath: "/run/containerd/containerd.sock"})
+	assert.Contains(t, c.Env, v1.EnvVar{Name: criSocketEnv, Value: "unix:///run/containerd/containerd.sock"})
+}
+
+func TestRunRetry(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh is not available")
+	}
+
+	uu := map[string]struct {
+		retries, fails int
+		stderr         string
+		err            bool
+		attempts       []int
+	}{
+		"ok": {
+			retries: 2,
+		},
+		"transient": {
+			retries:  2,
+			fails:    2,
+			stderr:   "net/http: TLS handshake timeout",
+			attempts: []int{1, 2},
+		},
+		"exhausted": {
+			retries:  1,
+			fails:    2,
+			stderr:   "connection reset by peer",
+			err:      true,
+			attempts: []int{1},
+		},
+		"permanent": {
+			retries: 2,
+			fails:   1,
+			stderr:  "Error from server (NotFound)",
+			err:     true,
+		},
+		"no-retries": {
+			fails:  1,
+			stderr: "Error from server (TooManyRequests)",
+			err:    true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			count := filepath.Join(t.TempDir(), "count")
+			script := fmt.Sprintf(
+				"n=$(cat %[1]s 2>/dev/null || echo 0); echo $((n+1)) > %[1]s; if [ $n -lt %[2]d ]; then echo %[3]q >&2; exit 1; fi; echo fred",
+				count, u.fails, u.stderr,
+			)
+			var attempts []int
+			opts := shellOpts{
+				background: true,
+				retries:    u.retries,
+				backoff:    time.Millisecond,
+				onRetry: func(attempt int, _ time.Duration) {
+					attempts = append(attempts, attempt)
+				},
+			}
+			cmd := exec.Command(sh, "-c", script)
+			var w, e bytes.Buffer
+			cmd.Stdout, cmd.Stderr = &w, &e
+
+			err := runRetry(context.Background(), &opts, cmd, &w, &e)
+			if u.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "fred\n", w.String())
+			}
+			assert.Equal(t, u.attempts, attempts)
+		})
+	}
+}
+
+func TestWithTempDir(t *testing.T) {
+	uu := map[string]struct {
+		env map[string]string
+		dir string
+		e   map[string]string
+	}{
+		"none": {
+			env: map[string]string{"fred": "blee"},
+			e:   map[string]string{"fred": "blee"},
+		},
+		"system": {
+			dir: os.TempDir(),
+		},
+		"configured": {
+			env: map[string]string{"fred": "blee"},
+			dir: "/var/tmp/k9s",
+			e:   map[string]string{"fred": "blee", "TMPDIR": "/var/tmp/k9s"},
+		},
+		"env-override": {
+			env: map[string]string{"TMPDIR": "/zorg"},
+			dir: "/var/tmp/k9s",
+			e:   map[string]string{"TMPDIR": "/zorg"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, withTempDir(u.env, u.dir))
+		})
+	}
+}
+
+func TestClassifyExecError(t *testing.T) {
+	uu := map[string]struct {
+		stderr, e string
+	}{
+		"forbidden": {
+			stderr: `Error from server (Forbidden): pods "fred" is forbidden: User "blee" cannot get resource "pods"`,
+			e:      "Access denied. Check your RBAC permissions on this resource",
+		},
+		"not-found": {
+			stderr: `Error from server (NotFound): pods "fred" not found`,
+			e:      "Resource not found. It may have been deleted or live in another namespace",
+		},
+		"unauthorized": {
+			stderr: "error: You must be logged in to the server (Unauthorized)",
+			e:      "Authentication failed. Check your kubeconfig credentials or refresh your token",
+		},
+		"refused": {
+			stderr: "The connection to the server localhost:8080 was refused - did you specify the right host or port?: dial tcp 127.0.0.1:8080: connect: connection refused",
+			e:      "Unable to reach the API server. Check your cluster connection",
+		},
+		"unknown": {
+			stderr: "error: unknown flag: --zorg",
+		},
+		"bin-not-found": {
+			stderr: `exec: "zorg": executable file not found in $PATH`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, classifyExecError(u.stderr))
+		})
+	}
+}
+
+func TestClassifyErr(t *testing.T) {
+	require.NoError(t, classifyErr(nil))
+
+	raw := errors.New("error: unknown flag: --zorg")
+	assert.Equal(t, raw, classifyErr(raw))
+
+	raw = errors.Join(errors.New("exit status 1"), errors.New(`Error from server (NotFound): pods "fred" not found`))
+	err := classifyErr(raw)
+	require.Error(t, err)
+	assert.Equal(t, "Resource not found. It may have been deleted or live in another namespace", err.Error())
+	assert.ErrorIs(t, err, raw)
+}
+
+func TestShellPodSpecYAML(t *testing.T) {
+	cs := fake.NewClientset(&v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		Status: v1.NodeStatus{
+			NodeInfo: v1.NodeSystemInfo{ContainerRuntimeVersion: "containerd://1.7.0"},
+		},
+	})
+	cfg := config.NewShellPod()
+	cfg.RootMountPath, cfg.RootMountTarget = "/var/log", "/logs"
+	cfg.MountCRISocket = true
+
+	po, err := shellPodSpec(context.Background(), cs.CoreV1().Nodes(), cfg, "node-1", false)
+	require.NoError(t, err)
+	raw, err := shellPodYAML(po)
+	require.NoError(t, err)
+
+	assert.Contains(t, raw, "apiVersion: v1\nkind: Pod\n")
+	assert.Contains(t, raw, "nodeName: node-1")
+	assert.Contains(t, raw, "path: /var/log")
+	assert.Contains(t, raw, "mountPath: /logs")
+	assert.Contains(t, raw, "privileged: true")
+	assert.Contains(t, raw, "path: /run/containerd/containerd.sock")
+
+	l, err := cs.CoreV1().Pods(cfg.Namespace).List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, l.Items)
+}
+
+func TestShellPodSpecInvalid(t *testing.T) {
+	cs := fake.NewClientset()
+	cfg := config.NewShellPod()
+
+	_, err := shellPodSpec(context.Background(), cs.CoreV1().Nodes(), cfg, "", false)
+	require.EqualError(t, err, "shell pod requires a node or a node selector/affinity")
+
+	cfg.ImageAllowlist = []string{"alpine:*"}
+	_, err = shellPodSpec(context.Background(), cs.CoreV1().Nodes(), cfg, "node-1", false)
+	require.Error(t, err)
+}
+
+func TestPluginArgs(t *testing.T) {
+	uu := map[string]struct {
+		plugin      string
+		args, flags []string
+		e           []string
+	}{
+		"plain": {
+			plugin: "neat",
+			e:      []string{"neat"},
+		},
+		"args": {
+			plugin: "neat",
+			args:   []string{"get", "po", "p1"},
+			e:      []string{"neat", "get", "po", "p1"},
+		},
+		"flags": {
+			plugin: "neat",
+			args:   []string{"get", "po", "p1"},
+			flags:  []string{"--context", "ctx1", "--as", "fred"},
+			e:      []string{"neat", "--context", "ctx1", "--as", "fred", "get", "po", "p1"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, pluginArgs(u.plugin, u.args, u.flags))
+		})
+	}
+}
+
+func TestResolveShellCheck(t *testing.T) {
+	uu := map[string]struct {
+		pod, ctx string
+		login    bool
+		e        string
+	}{
+		"default": {
+			e: shellCheck,
+		},
+		"login": {
+			login: true,
+			e:     `command -v bash >/dev/null && exec bash -l || exec sh -l`,
+		},
+		"login-custom": {
+			ctx:   "exec dash -l",
+			login: true,
+			e:     "exec dash -l",
+		},
+		"shell-pod": {
+			pod: "exec ash",
+			e:   "exec ash",
+		},
+		"context": {
+			ctx: "exec dash",
+			e:   "exec dash",
+		},
+		"context-wins": {
+			pod: "exec ash",
+			ctx: "exec dash",
+			e:   "exec dash",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ct, cfg := data.NewContext(), config.NewShellPod()
+			ct.ShellCheck, cfg.ShellCheck, cfg.LoginShell = u.ctx, u.pod, u.login
+			assert.Equal(t, u.e, resolveShellCheck(ct, cfg))
+		})
+	}
+	assert.Equal(t, shellCheck, resolveShellCheck(nil, nil))
+}
+
+func TestLoginShell(t *testing.T) {
+	uu := map[string]struct {
+		check, e string
+	}{
+		"default": {
+			check: shellCheck,
+			e:     `command -v bash >/dev/null && exec bash -l || exec sh -l`,
+		},
+		"single": {
+			check: "exec ash",
+			e:     "exec ash -l",
+		},
+		"already-login": {
+			check: "exec ash -l",
+			e:     "exec ash -l",
+		},
+		"no-exec": {
+			check: "ash",
+			e:     "ash",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, loginShell(u.check))
+		})
+	}
+}
@@ -74,6 +74,9 @@ func (n *Node) bindDangerousKeys(aa *ui.KeyActions) {
 	}
 	if ct.FeatureGates.NodeShell && n.App().Config.K9s.ShellPod != nil {
 		aa.Add(ui.KeyS, ui.NewKeyAction("Shell", n.sshCmd, true))
+		if n.App().Config.K9s.ShellPod.HasScheduling() {
+			aa.Add(ui.KeyShiftS, ui.NewKeyAction("Shell (Scheduled)", n.sshScheduledCmd, true))
+		}
 	}
 }
 
@@ -89,6 +92,9 @@ func (n *Node) bindKeys(aa *ui.KeyActions) {
 		ui.KeyShiftM: ui.NewKeyAction("Sort MEM", n.GetTable().SortColCmd(memCol, false), false),
 		ui.KeyShiftO: ui.NewKeyAction("Sort Pods", n.GetTable().SortColCmd("PODS", false), false),
 	})
+	if ct, err := n.App().Config.K9s.ActiveContext(); err == nil && ct.FeatureGates.NodeShell && n.App().Config.K9s.ShellPod != nil {
+		aa.Add(ui.KeyShiftY, ui.NewKeyAction("Shell Pod Spec", n.shellSpecCmd, true))
+	}
 }
 
 func (n *Node) showPods(a *App, _ ui.Tabular, _ *client.GVR, path string) {
@@ -194,6 +200,49 @@ func (n *Node) sshCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+// sshScheduledCmd launches a node shell on any node matching the shell pod node selector/affinity.
+func (n *Node) sshScheduledCmd(*tcell.EventKey) *tcell.EventKey {
+	n.Stop()
+	defer n.Start()
+	launchNodeShell(n, n.App(), "")
+
+	return nil
+}
+
+// shellSpecCmd previews the shell pod spec for the selected node without creating it.
+func (n *Node) shellSpecCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), n.App().Conn().Config().CallTimeout())
+	defer cancel()
+	dial, err := n.App().Conn().Dial()
+	if err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+	_, node := client.Namespaced(path)
+	po, err := shellPodSpec(ctx, dial.CoreV1().Nodes(), n.App().Config.K9s.ShellPod, node, readOnlyNodeShell(n.App()))
+	if err != nil {
+		n.App().Flash().Errf("Shell pod spec failed: %s", err)
+		return nil
+	}
+	raw, err := shellPodYAML(po)
+	if err != nil {
+		n.App().Flash().Errf("Unable to marshal shell pod %s", err)
+		return nil
+	}
+
+	details := NewDetails(n.App(), "Shell Pod Spec", node, contentYAML, true).Update(raw)
+	if err := n.App().inject(details, false); err != nil {
+		n.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (n *Node) yamlCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := n.GetTable().GetSelectedItem()
 	if path == "" {
@@ -22,7 +22,6 @@ import (
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
-	"github.com/fatih/color"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -43,6 +42,9 @@ const (
 	magicPrompt      = "Yes Please!"
 )
 
+// defaultRootShellImage tracks the ephemeral container image used for root shells.
+const defaultRootShellImage = "busybox:1.37.0"
+
 // Pod represents a pod viewer.
 type Pod struct {
 	ResourceViewer
@@ -122,6 +124,15 @@ func (p *Pod) bindDangerousKeys(aa *ui.KeyActions) {
 				Dangerous: true,
 			}),
 	})
+	if ct, err := p.App().Config.K9s.ActiveContext(); err == nil && ct.FeatureGates.RootShell {
+		aa.Add(tcell.KeyCtrlT, ui.NewKeyActionWithOpts(
+			"Root Shell",
+			p.rootShellCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}))
+	}
 }
 
 func (p *Pod) bindKeys(aa *ui.KeyActions) {
@@ -244,6 +255,29 @@ func (p *Pod) shellCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (p *Pod) rootShellCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	if !podIsRunning(p.App().factory, path) {
+		p.App().Flash().Errf("%s is not in a running state", path)
+		return nil
+	}
+
+	err := pickContainer(p.App(), path, "", func(co string) {
+		p.Stop()
+		defer p.Start()
+		rootShellIn(p.App(), path, co)
+	})
+	if err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (p *Pod) attachCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := p.GetTable().GetSelectedItem()
 	if path == "" {
@@ -363,8 +397,16 @@ func (p *Pod) transferCmd(*tcell.EventKey) *tcell.EventKey {
 // Helpers...
 
 func containerShellIn(a *App, comp model.Component, path, co string) error {
-	if co != "" {
+	return pickContainer(a, path, co, func(co string) {
 		resumeShellIn(a, comp, path, co)
+	})
+}
+
+// pickContainer resolves the pod container to shell into, prompting the user
+// when the pod has several containers and no default one.
+func pickContainer(a *App, path, co string, shell func(co string)) error {
+	if co != "" {
+		shell(co)
 		return nil
 	}
 
@@ -373,20 +415,20 @@ func containerShellIn(a *App, comp model.Component, path, co string) error {
 		return err
 	}
 	if dco, ok := dao.GetDefaultContainer(&pod.ObjectMeta, &pod.Spec); ok {
-		resumeShellIn(a, comp, path, dco)
+		shell(dco)
 		return nil
 	}
 
 	cc := fetchContainers(&pod.ObjectMeta, &pod.Spec, false)
 	if len(cc) == 1 {
-		resumeShellIn(a, comp, path, cc[0])
+		shell(cc[0])
 		return nil
 	}
 
 	picker := NewPicker()
 	picker.populate(cc)
 	picker.SetSelectedFunc(func(_ int, co, _ string, _ rune) {
-		resumeShellIn(a, comp, path, co)
+		shell(co)
 	})
 
 	return a.inject(picker, false)
@@ -406,10 +448,9 @@ func shellIn(a *App, fqn, co string) {
 	}
 	args := computeShellArgs(fqn, co, a.Conn().Config().Flags(), platform)
 
-	c := color.New(color.BgGreen).Add(color.FgBlack).Add(color.Bold)
 	err = runK(a, &shellOpts{
 		clear:  true,
-		banner: c.Sprintf(bannerFmt, fqn, co),
+		banner: shellBanner(execBanner(a), fqn, co, false),
 		args:   args},
 	)
 	if err != nil {
@@ -417,6 +458,86 @@ func shellIn(a *App, fqn, co string) {
 	}
 }
 
+// rootShellIn shells into the given container as root. Containers that can not
+// elevate are debugged via an ephemeral root container targeting them instead.
+func rootShellIn(a *App, fqn, co string) {
+	pod, err := fetchPod(a.factory, fqn)
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	if pod.Spec.OS != nil && pod.Spec.OS.Name == windowsOS {
+		a.Flash().Errf("Root shells are not supported on windows pods")
+		return
+	}
+	img := defaultRootShellImage
+	if cfg := a.Config.K9s.ShellPod; cfg != nil && cfg.Image != "" {
+		img = cfg.Image
+	}
+	args := rootShellArgs(fqn, co, a.Conn().Config().Flags(), &pod.Spec, img)
+	slog.Warn("Escalating to a root shell",
+		slogs.FQN, fqn,
+		slogs.Container, co,
+		slogs.Args, args,
+	)
+
+	err = runK(a, &shellOpts{
+		clear:  true,
+		banner: rootShellBanner(execBanner(a), fqn, co),
+		args:   args},
+	)
+	if err != nil {
+		a.Flash().Errf("Root shell exec failed: %s", err)
+		return
+	}
+	a.Flash().Warnf("Root shell session on %s/%s ended", fqn, co)
+}
+
+// rootShellArgs builds the kubectl arguments to land a root shell in the given container.
+// Containers allowing privilege escalation are exec'ed into and elevated via sudo if need be.
+// Others are debugged via an ephemeral container running as root.
+func rootShellArgs(path, co string, flags *genericclioptions.ConfigFlags, spec *v1.PodSpec, img string) []string {
+	if canElevate(spec, co) {
+		return append(buildShellArgs("exec", path, co, flags), "--", "sh", "-c", rootShellCheck)
+	}
+
+	args := buildShellArgs("debug", path, "", flags)
+
+	return append(args, "--image", img, "--target", co, "--profile", "sysadmin", "--", "sh")
+}
+
+// canElevate checks if the given container security context allows running as root.
+func canElevate(spec *v1.PodSpec, co string) bool {
+	var (
+		nonRoot *bool
+		uid     *int64
+	)
+	if psc := spec.SecurityContext; psc != nil {
+		nonRoot, uid = psc.RunAsNonRoot, psc.RunAsUser
+	}
+	for _, c := range spec.Containers {
+		if c.Name != co {
+			continue
+		}
+		if sc := c.SecurityContext; sc != nil {
+			if sc.RunAsNonRoot != nil {
+				nonRoot = sc.RunAsNonRoot
+			}
+			if sc.RunAsUser != nil {
+				uid = sc.RunAsUser
+			}
+			if sc.AllowPrivilegeEscalation != nil && !*sc.AllowPrivilegeEscalation && (uid == nil || *uid != 0) {
+				return false
+			}
+		}
+	}
+	if nonRoot != nil && *nonRoot {
+		return false
+	}
+
+	return uid == nil || *uid == 0
+}
+
 func containerAttachIn(a *App, comp model.Component, path, co string) error {
 	if co != "" {
 		resumeAttachIn(a, comp, path, co)
@@ -453,8 +574,7 @@ func resumeAttachIn(a *App, c model.Component, path, co string) {
 
 func attachIn(a *App, path, co string) {
 	args := buildShellArgs("attach", path, co, a.Conn().Config().Flags())
-	c := color.New(color.BgGreen).Add(color.FgBlack).Add(color.Bold)
-	if err := runK(a, &shellOpts{clear: true, banner: c.Sprintf(bannerFmt, path, co), args: args}); err != nil {
+	if err := runK(a, &shellOpts{clear: true, banner: shellBanner(execBanner(a), path, co, false), args: args}); err != nil {
 		a.Flash().Errf("Attach exec failed: %s", err)
 	}
 }
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 )
 
@@ -80,3 +81,71 @@ func TestComputeShellArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestRootShellArgs(t *testing.T) {
+	root, nonRoot, yes, no := int64(0), int64(1000), true, false
+
+	uu := map[string]struct {
+		spec v1.PodSpec
+		cfg  *genericclioptions.ConfigFlags
+		e    string
+	}{
+		"default-user": {
+			spec: v1.PodSpec{Containers: []v1.Container{{Name: "c1"}}},
+			e:    "exec -it -n fred blee -c c1 -- sh -c " + rootShellCheck,
+		},
+		"root-user": {
+			spec: v1.PodSpec{
+				Containers: []v1.Container{{
+					Name:            "c1",
+					SecurityContext: &v1.SecurityContext{RunAsUser: &root, AllowPrivilegeEscalation: &no},
+				}},
+			},
+			cfg: &genericclioptions.ConfigFlags{Context: newStr("coolContext")},
+			e:   "exec -it -n fred blee --context coolContext -c c1 -- sh -c " + rootShellCheck,
+		},
+		"non-root-pod": {
+			spec: v1.PodSpec{
+				SecurityContext: &v1.PodSecurityContext{RunAsUser: &nonRoot},
+				Containers:      []v1.Container{{Name: "c1"}},
+			},
+			e: "debug -it -n fred blee --image busybox --target c1 --profile sysadmin -- sh",
+		},
+		"no-escalation": {
+			spec: v1.PodSpec{
+				Containers: []v1.Container{{
+					Name:            "c1",
+					SecurityContext: &v1.SecurityContext{AllowPrivilegeEscalation: &no},
+				}},
+			},
+			cfg: &genericclioptions.ConfigFlags{KubeConfig: newStr("coolConfig")},
+			e:   "debug -it -n fred blee --kubeconfig coolConfig --image busybox --target c1 --profile sysadmin -- sh",
+		},
+		"container-override": {
+			spec: v1.PodSpec{
+				SecurityContext: &v1.PodSecurityContext{RunAsUser: &nonRoot},
+				Containers: []v1.Container{
+					{Name: "c1", SecurityContext: &v1.SecurityContext{RunAsUser: &root}},
+				},
+			},
+			e: "exec -it -n fred blee -c c1 -- sh -c " + rootShellCheck,
+		},
+		"run-as-non-root": {
+			spec: v1.PodSpec{
+				Containers: []v1.Container{
+					{Name: "c0"},
+					{Name: "c1", SecurityContext: &v1.SecurityContext{RunAsNonRoot: &yes}},
+				},
+			},
+			e: "debug -it -n fred blee --image busybox --target c1 --profile sysadmin -- sh",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			args := rootShellArgs("fred/blee", "c1", u.cfg, &u.spec, "busybox")
+			assert.Equal(t, u.e, strings.Join(args, " "))
+		})
+	}
+}
@@ -50,7 +50,7 @@ func (s *ScreenDump) edit(app *App, _ ui.Tabular, _ *client.GVR, path string) {
 
 	s.Stop()
 	defer s.Start()
-	if !edit(app, &shellOpts{clear: true, args: []string{path}}) {
+	if !edit(app, &shellOpts{clear: true, args: []string{path}}, false) {
 		app.Flash().Errf("Failed to launch editor")
 	}
 }
@@ -7,12 +7,15 @@ import (
 	"context"
 	"log/slog"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
@@ -52,6 +55,7 @@ func (t *Table) Init(ctx context.Context) (err error) {
 	ctx = context.WithValue(ctx, internal.KeyStyles, t.app.Styles)
 	ctx = context.WithValue(ctx, internal.KeyViewConfig, t.app.CustomView())
 	t.Table.Init(ctx)
+	t.SetCustomFilters(t.app.CustomFilters())
 	if !t.app.Config.K9s.UI.Reactive {
 		if err := t.app.RefreshCustomViews(); err != nil {
 			slog.Warn("CustomViews load failed", slogs.Error, err)
@@ -172,11 +176,39 @@ func (*Table) SetExtraActionsFn(BoostActionsFunc) {}
 
 // BufferCompleted indicates input was accepted.
 func (t *Table) BufferCompleted(text, _ string) {
+	if name, query, ok := ui.ParseNamedFilter(text); ok {
+		t.namedFilter(name, query)
+	}
 	t.app.QueueUpdateDraw(func() {
 		t.Filter(text)
 	})
 }
 
+// namedFilter lists, saves or checks the given named filter for the table resource.
+func (t *Table) namedFilter(name, query string) {
+	cf, data := t.app.CustomFilters(), t.GetModel().Peek()
+	switch {
+	case name == "":
+		if nn := data.NamedFilters(cf); len(nn) > 0 {
+			t.app.Flash().Infof("Saved filters: %s", strings.Join(nn, ", "))
+		} else {
+			t.app.Flash().Warnf("No saved filters for %s", t.GVR())
+		}
+	case query != "":
+		if err := data.SaveFilter(cf, name, model1.FilterOpts{Filter: query, Toast: t.IsToast()}); err != nil {
+			t.app.Flash().Err(err)
+			return
+		}
+		if err := cf.Save(config.AppFiltersFile); err != nil {
+			t.app.Flash().Errf("Unable to save filter %q: %s", name, err)
+			return
+		}
+		t.app.Flash().Infof("Filter %q saved", name)
+	case !slices.Contains(data.NamedFilters(cf), name):
+		t.app.Flash().Errf("No filter named %q for %s", name, t.GVR())
+	}
+}
+
 // BufferChanged indicates the buffer was changed.
 func (*Table) BufferChanged(_, _ string) {}
 
@@ -4,7 +4,6 @@
 package view
 
 import (
-	"encoding/csv"
 	"fmt"
 	"log/slog"
 	"os"
@@ -68,15 +67,7 @@ func saveTable(dir, title, path string, mdata *model1.TableData) (string, error)
 		}
 	}()
 
-	w := csv.NewWriter(out)
-	_ = w.Write(mdata.ColumnNames(true))
-
-	mdata.RowsRange(func(_ int, re model1.RowEvent) bool {
-		_ = w.Write(re.Row.Fields)
-		return true
-	})
-	w.Flush()
-	if err := w.Error(); err != nil {
+	if err := mdata.ExportCSV(out, model1.ExportOpts{Wide: true}); err != nil {
 		return "", err
 	}
 
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"io/fs"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -85,6 +86,31 @@ func TestTableViewFilter(t *testing.T) {
 	assert.Equal(t, 5, v.GetRowCount())
 }
 
+func TestTableViewNamedFilter(t *testing.T) {
+	defer func(f string) { config.AppFiltersFile = f }(config.AppFiltersFile)
+	config.AppFiltersFile = filepath.Join(t.TempDir(), "filters.yaml")
+
+	v := NewTable(client.NewGVR("test"))
+	require.NoError(t, v.Init(makeContext(t)))
+	v.SetModel(&mockTableModel{})
+
+	v.CmdBuff().SetText("@hot=r[12]", "")
+	assert.Equal(t, 2, v.GetFilteredData().RowCount())
+
+	cf := config.NewCustomFilters()
+	require.NoError(t, cf.Load(config.AppFiltersFile))
+	assert.Equal(t, []string{"hot"}, cf.Names("test"))
+
+	v.CmdBuff().SetText("r3", "")
+	assert.Equal(t, 1, v.GetFilteredData().RowCount())
+
+	v.CmdBuff().SetText("@hot", "")
+	assert.Equal(t, 2, v.GetFilteredData().RowCount())
+
+	v.CmdBuff().SetText("@", "")
+	assert.Equal(t, 4, v.GetFilteredData().RowCount())
+}
+
 func TestTableViewSort(t *testing.T) {
 	v := NewTable(client.NewGVR("test"))
 	require.NoError(t, v.Init(makeContext(t)))
@@ -162,8 +188,9 @@ func (*mockTableModel) Describe(context.Context, string) (string, error) {
 func (*mockTableModel) ToYAML(context.Context, string) (string, error) {
 	return "", nil
 }
-func (*mockTableModel) InNamespace(string) bool      { return true }
-func (*mockTableModel) SetRefreshRate(time.Duration) {}
+func (*mockTableModel) InNamespace(string) bool          { return true }
+func (*mockTableModel) SetRefreshRate(time.Duration)     {}
+func (*mockTableModel) SetDeleteRetention(time.Duration) {}
 
 func makeTableData() *model1.TableData {
 	return model1.NewTableDataWithRows(